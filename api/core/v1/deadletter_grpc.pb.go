@@ -0,0 +1,137 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// DeadLetterClient is the client API for DeadLetter service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeadLetterClient interface {
+	ListDeadLetter(ctx context.Context, in *ListDeadLetterRequest, opts ...grpc.CallOption) (*ListDeadLetterResponse, error)
+	ReplayDeadLetter(ctx context.Context, in *ReplayDeadLetterRequest, opts ...grpc.CallOption) (*ReplayDeadLetterResponse, error)
+}
+
+type deadLetterClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeadLetterClient(cc grpc.ClientConnInterface) DeadLetterClient {
+	return &deadLetterClient{cc}
+}
+
+func (c *deadLetterClient) ListDeadLetter(ctx context.Context, in *ListDeadLetterRequest, opts ...grpc.CallOption) (*ListDeadLetterResponse, error) {
+	out := new(ListDeadLetterResponse)
+	err := c.cc.Invoke(ctx, "/api.core.v1.DeadLetter/ListDeadLetter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deadLetterClient) ReplayDeadLetter(ctx context.Context, in *ReplayDeadLetterRequest, opts ...grpc.CallOption) (*ReplayDeadLetterResponse, error) {
+	out := new(ReplayDeadLetterResponse)
+	err := c.cc.Invoke(ctx, "/api.core.v1.DeadLetter/ReplayDeadLetter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeadLetterServer is the server API for DeadLetter service.
+// All implementations must embed UnimplementedDeadLetterServer
+// for forward compatibility
+type DeadLetterServer interface {
+	ListDeadLetter(context.Context, *ListDeadLetterRequest) (*ListDeadLetterResponse, error)
+	ReplayDeadLetter(context.Context, *ReplayDeadLetterRequest) (*ReplayDeadLetterResponse, error)
+	mustEmbedUnimplementedDeadLetterServer()
+}
+
+// UnimplementedDeadLetterServer must be embedded to have forward compatible implementations.
+type UnimplementedDeadLetterServer struct {
+}
+
+func (UnimplementedDeadLetterServer) ListDeadLetter(context.Context, *ListDeadLetterRequest) (*ListDeadLetterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetter not implemented")
+}
+func (UnimplementedDeadLetterServer) ReplayDeadLetter(context.Context, *ReplayDeadLetterRequest) (*ReplayDeadLetterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetter not implemented")
+}
+func (UnimplementedDeadLetterServer) mustEmbedUnimplementedDeadLetterServer() {}
+
+// UnsafeDeadLetterServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeadLetterServer will
+// result in compilation errors.
+type UnsafeDeadLetterServer interface {
+	mustEmbedUnimplementedDeadLetterServer()
+}
+
+func RegisterDeadLetterServer(s grpc.ServiceRegistrar, srv DeadLetterServer) {
+	s.RegisterService(&DeadLetter_ServiceDesc, srv)
+}
+
+func _DeadLetter_ListDeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeadLetterServer).ListDeadLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.core.v1.DeadLetter/ListDeadLetter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeadLetterServer).ListDeadLetter(ctx, req.(*ListDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeadLetter_ReplayDeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeadLetterServer).ReplayDeadLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.core.v1.DeadLetter/ReplayDeadLetter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeadLetterServer).ReplayDeadLetter(ctx, req.(*ReplayDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DeadLetter_ServiceDesc is the grpc.ServiceDesc for DeadLetter service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DeadLetter_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.core.v1.DeadLetter",
+	HandlerType: (*DeadLetterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDeadLetter",
+			Handler:    _DeadLetter_ListDeadLetter_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetter",
+			Handler:    _DeadLetter_ReplayDeadLetter_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/core/v1/deadletter.proto",
+}
@@ -32,11 +32,16 @@ type EntityClient interface {
 	PatchEntityConfigsZ(ctx context.Context, in *PatchEntityConfigsRequest, opts ...grpc.CallOption) (*EntityResponse, error)
 	RemoveEntityConfigs(ctx context.Context, in *RemoveEntityConfigsRequest, opts ...grpc.CallOption) (*EntityResponse, error)
 	GetEntityConfigs(ctx context.Context, in *GetEntityConfigsRequest, opts ...grpc.CallOption) (*EntityResponse, error)
+	ResolveEntityConfigs(ctx context.Context, in *ResolveEntityConfigsRequest, opts ...grpc.CallOption) (*ResolveEntityConfigsResponse, error)
 	AppendMapper(ctx context.Context, in *AppendMapperRequest, opts ...grpc.CallOption) (*AppendMapperResponse, error)
 	GetMapper(ctx context.Context, in *GetMapperRequest, opts ...grpc.CallOption) (*GetMapperResponse, error)
 	ListMapper(ctx context.Context, in *ListMapperRequest, opts ...grpc.CallOption) (*ListMapperResponse, error)
 	RemoveMapper(ctx context.Context, in *RemoveMapperRequest, opts ...grpc.CallOption) (*RemoveMapperResponse, error)
 	ListEntity(ctx context.Context, in *ListEntityRequest, opts ...grpc.CallOption) (*ListEntityResponse, error)
+	BatchCreateEntities(ctx context.Context, in *BatchCreateEntitiesRequest, opts ...grpc.CallOption) (*BatchEntitiesResponse, error)
+	BatchPatchEntities(ctx context.Context, in *BatchPatchEntitiesRequest, opts ...grpc.CallOption) (*BatchEntitiesResponse, error)
+	BatchDeleteEntities(ctx context.Context, in *BatchDeleteEntitiesRequest, opts ...grpc.CallOption) (*BatchEntitiesResponse, error)
+	CommitTransaction(ctx context.Context, in *CommitTransactionRequest, opts ...grpc.CallOption) (*CommitTransactionResponse, error)
 }
 
 type entityClient struct {
@@ -173,6 +178,15 @@ func (c *entityClient) GetEntityConfigs(ctx context.Context, in *GetEntityConfig
 	return out, nil
 }
 
+func (c *entityClient) ResolveEntityConfigs(ctx context.Context, in *ResolveEntityConfigsRequest, opts ...grpc.CallOption) (*ResolveEntityConfigsResponse, error) {
+	out := new(ResolveEntityConfigsResponse)
+	err := c.cc.Invoke(ctx, "/api.core.v1.Entity/ResolveEntityConfigs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *entityClient) AppendMapper(ctx context.Context, in *AppendMapperRequest, opts ...grpc.CallOption) (*AppendMapperResponse, error) {
 	out := new(AppendMapperResponse)
 	err := c.cc.Invoke(ctx, "/api.core.v1.Entity/AppendMapper", in, out, opts...)
@@ -218,6 +232,42 @@ func (c *entityClient) ListEntity(ctx context.Context, in *ListEntityRequest, op
 	return out, nil
 }
 
+func (c *entityClient) BatchCreateEntities(ctx context.Context, in *BatchCreateEntitiesRequest, opts ...grpc.CallOption) (*BatchEntitiesResponse, error) {
+	out := new(BatchEntitiesResponse)
+	err := c.cc.Invoke(ctx, "/api.core.v1.Entity/BatchCreateEntities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityClient) BatchPatchEntities(ctx context.Context, in *BatchPatchEntitiesRequest, opts ...grpc.CallOption) (*BatchEntitiesResponse, error) {
+	out := new(BatchEntitiesResponse)
+	err := c.cc.Invoke(ctx, "/api.core.v1.Entity/BatchPatchEntities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityClient) BatchDeleteEntities(ctx context.Context, in *BatchDeleteEntitiesRequest, opts ...grpc.CallOption) (*BatchEntitiesResponse, error) {
+	out := new(BatchEntitiesResponse)
+	err := c.cc.Invoke(ctx, "/api.core.v1.Entity/BatchDeleteEntities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityClient) CommitTransaction(ctx context.Context, in *CommitTransactionRequest, opts ...grpc.CallOption) (*CommitTransactionResponse, error) {
+	out := new(CommitTransactionResponse)
+	err := c.cc.Invoke(ctx, "/api.core.v1.Entity/CommitTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EntityServer is the server API for Entity service.
 // All implementations must embed UnimplementedEntityServer
 // for forward compatibility
@@ -236,11 +286,16 @@ type EntityServer interface {
 	PatchEntityConfigsZ(context.Context, *PatchEntityConfigsRequest) (*EntityResponse, error)
 	RemoveEntityConfigs(context.Context, *RemoveEntityConfigsRequest) (*EntityResponse, error)
 	GetEntityConfigs(context.Context, *GetEntityConfigsRequest) (*EntityResponse, error)
+	ResolveEntityConfigs(context.Context, *ResolveEntityConfigsRequest) (*ResolveEntityConfigsResponse, error)
 	AppendMapper(context.Context, *AppendMapperRequest) (*AppendMapperResponse, error)
 	GetMapper(context.Context, *GetMapperRequest) (*GetMapperResponse, error)
 	ListMapper(context.Context, *ListMapperRequest) (*ListMapperResponse, error)
 	RemoveMapper(context.Context, *RemoveMapperRequest) (*RemoveMapperResponse, error)
 	ListEntity(context.Context, *ListEntityRequest) (*ListEntityResponse, error)
+	BatchCreateEntities(context.Context, *BatchCreateEntitiesRequest) (*BatchEntitiesResponse, error)
+	BatchPatchEntities(context.Context, *BatchPatchEntitiesRequest) (*BatchEntitiesResponse, error)
+	BatchDeleteEntities(context.Context, *BatchDeleteEntitiesRequest) (*BatchEntitiesResponse, error)
+	CommitTransaction(context.Context, *CommitTransactionRequest) (*CommitTransactionResponse, error)
 	mustEmbedUnimplementedEntityServer()
 }
 
@@ -290,6 +345,9 @@ func (UnimplementedEntityServer) RemoveEntityConfigs(context.Context, *RemoveEnt
 func (UnimplementedEntityServer) GetEntityConfigs(context.Context, *GetEntityConfigsRequest) (*EntityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEntityConfigs not implemented")
 }
+func (UnimplementedEntityServer) ResolveEntityConfigs(context.Context, *ResolveEntityConfigsRequest) (*ResolveEntityConfigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveEntityConfigs not implemented")
+}
 func (UnimplementedEntityServer) AppendMapper(context.Context, *AppendMapperRequest) (*AppendMapperResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AppendMapper not implemented")
 }
@@ -305,6 +363,18 @@ func (UnimplementedEntityServer) RemoveMapper(context.Context, *RemoveMapperRequ
 func (UnimplementedEntityServer) ListEntity(context.Context, *ListEntityRequest) (*ListEntityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEntity not implemented")
 }
+func (UnimplementedEntityServer) BatchCreateEntities(context.Context, *BatchCreateEntitiesRequest) (*BatchEntitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateEntities not implemented")
+}
+func (UnimplementedEntityServer) BatchPatchEntities(context.Context, *BatchPatchEntitiesRequest) (*BatchEntitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchPatchEntities not implemented")
+}
+func (UnimplementedEntityServer) BatchDeleteEntities(context.Context, *BatchDeleteEntitiesRequest) (*BatchEntitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteEntities not implemented")
+}
+func (UnimplementedEntityServer) CommitTransaction(context.Context, *CommitTransactionRequest) (*CommitTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitTransaction not implemented")
+}
 func (UnimplementedEntityServer) mustEmbedUnimplementedEntityServer() {}
 
 // UnsafeEntityServer may be embedded to opt out of forward compatibility for this service.
@@ -570,6 +640,24 @@ func _Entity_GetEntityConfigs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Entity_ResolveEntityConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveEntityConfigsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityServer).ResolveEntityConfigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.core.v1.Entity/ResolveEntityConfigs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityServer).ResolveEntityConfigs(ctx, req.(*ResolveEntityConfigsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Entity_AppendMapper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AppendMapperRequest)
 	if err := dec(in); err != nil {
@@ -660,6 +748,78 @@ func _Entity_ListEntity_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Entity_BatchCreateEntities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateEntitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityServer).BatchCreateEntities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.core.v1.Entity/BatchCreateEntities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityServer).BatchCreateEntities(ctx, req.(*BatchCreateEntitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Entity_BatchPatchEntities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchPatchEntitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityServer).BatchPatchEntities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.core.v1.Entity/BatchPatchEntities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityServer).BatchPatchEntities(ctx, req.(*BatchPatchEntitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Entity_BatchDeleteEntities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteEntitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityServer).BatchDeleteEntities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.core.v1.Entity/BatchDeleteEntities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityServer).BatchDeleteEntities(ctx, req.(*BatchDeleteEntitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Entity_CommitTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityServer).CommitTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.core.v1.Entity/CommitTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityServer).CommitTransaction(ctx, req.(*CommitTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Entity_ServiceDesc is the grpc.ServiceDesc for Entity service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -723,6 +883,10 @@ var Entity_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEntityConfigs",
 			Handler:    _Entity_GetEntityConfigs_Handler,
 		},
+		{
+			MethodName: "ResolveEntityConfigs",
+			Handler:    _Entity_ResolveEntityConfigs_Handler,
+		},
 		{
 			MethodName: "AppendMapper",
 			Handler:    _Entity_AppendMapper_Handler,
@@ -743,6 +907,22 @@ var Entity_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListEntity",
 			Handler:    _Entity_ListEntity_Handler,
 		},
+		{
+			MethodName: "BatchCreateEntities",
+			Handler:    _Entity_BatchCreateEntities_Handler,
+		},
+		{
+			MethodName: "BatchPatchEntities",
+			Handler:    _Entity_BatchPatchEntities_Handler,
+		},
+		{
+			MethodName: "BatchDeleteEntities",
+			Handler:    _Entity_BatchDeleteEntities_Handler,
+		},
+		{
+			MethodName: "CommitTransaction",
+			Handler:    _Entity_CommitTransaction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/core/v1/entity.proto",
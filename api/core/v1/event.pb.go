@@ -29,6 +29,7 @@ type PatchData struct {
 	Path     string `protobuf:"bytes,1,opt,name=path,proto3" json:"path"`
 	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator"`
 	Value    []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value"`
+	From     string `protobuf:"bytes,4,opt,name=from,proto3" json:"from"`
 }
 
 func (x *PatchData) Reset() {
@@ -84,6 +85,13 @@ func (x *PatchData) GetValue() []byte {
 	return nil
 }
 
+func (x *PatchData) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
 type PatchDatas struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -199,6 +207,7 @@ type ProtoEvent struct {
 	//	*ProtoEvent_RawData
 	//	*ProtoEvent_Patches
 	//	*ProtoEvent_SystemData
+	//	*ProtoEvent_Batch
 	Data isProtoEvent_Data `protobuf_oneof:"data"`
 }
 
@@ -290,6 +299,13 @@ func (x *ProtoEvent) GetSystemData() *SystemData {
 	return nil
 }
 
+func (x *ProtoEvent) GetBatch() *ProtoEvents {
+	if x, ok := x.GetData().(*ProtoEvent_Batch); ok {
+		return x.Batch
+	}
+	return nil
+}
+
 type isProtoEvent_Data interface {
 	isProtoEvent_Data()
 }
@@ -306,12 +322,65 @@ type ProtoEvent_SystemData struct {
 	SystemData *SystemData `protobuf:"bytes,10,opt,name=system_data,json=systemData,proto3,oneof"`
 }
 
+type ProtoEvent_Batch struct {
+	Batch *ProtoEvents `protobuf:"bytes,11,opt,name=batch,proto3,oneof"`
+}
+
 func (*ProtoEvent_RawData) isProtoEvent_Data() {}
 
 func (*ProtoEvent_Patches) isProtoEvent_Data() {}
 
 func (*ProtoEvent_SystemData) isProtoEvent_Data() {}
 
+func (*ProtoEvent_Batch) isProtoEvent_Data() {}
+
+type ProtoEvents struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*ProtoEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events"`
+}
+
+func (x *ProtoEvents) Reset() {
+	*x = ProtoEvents{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_core_v1_event_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoEvents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoEvents) ProtoMessage() {}
+
+func (x *ProtoEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_v1_event_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoEvents.ProtoReflect.Descriptor instead.
+func (*ProtoEvents) Descriptor() ([]byte, []int) {
+	return file_api_core_v1_event_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProtoEvents) GetEvents() []*ProtoEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
 var File_api_core_v1_event_proto protoreflect.FileDescriptor
 
 var file_api_core_v1_event_proto_rawDesc = []byte{
@@ -320,7 +389,7 @@ var file_api_core_v1_event_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67,
 	0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74,
 	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x90, 0x01, 0x0a, 0x09, 0x50, 0x61, 0x74, 0x63, 0x68,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd0, 0x01, 0x0a, 0x09, 0x50, 0x61, 0x74, 0x63, 0x68,
 	0x44, 0x61, 0x74, 0x61, 0x12, 0x2d, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x16, 0x32, 0x14, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20,
 	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x20, 0x70, 0x61, 0x74, 0x68, 0x52, 0x04, 0x70,
@@ -329,7 +398,11 @@ var file_api_core_v1_event_proto_rawDesc = []byte{
 	0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x29,
 	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x13, 0x92,
 	0x41, 0x10, 0x32, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x20, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3e, 0x0a, 0x0a, 0x50, 0x61, 0x74,
+	0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3e, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0x92, 0x41, 0x27, 0x32, 0x25, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x20, 0x70, 0x61, 0x74, 0x68, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x6f, 0x70,
+	0x79, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6d, 0x6f, 0x76, 0x65, 0x20, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x3e, 0x0a, 0x0a, 0x50, 0x61, 0x74,
 	0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x63, 0x68,
 	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
 	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61,
@@ -337,7 +410,7 @@ var file_api_core_v1_event_proto_rawDesc = []byte{
 	0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
 	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
 	0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xec, 0x02, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x74,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9e, 0x03, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x74,
 	0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
 	0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
@@ -356,15 +429,22 @@ var file_api_core_v1_event_proto_rawDesc = []byte{
 	0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69,
 	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44,
 	0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74,
-	0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x06,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0x38, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2d, 0x69, 0x6f, 0x2f, 0x63, 0x6f, 0x72,
-	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x12, 0x30, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x48, 0x00, 0x52, 0x05, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3e, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x38, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2d, 0x69, 0x6f, 0x2f, 0x63,
+	0x6f, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x3b,
+	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -379,24 +459,27 @@ func file_api_core_v1_event_proto_rawDescGZIP() []byte {
 	return file_api_core_v1_event_proto_rawDescData
 }
 
-var file_api_core_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_api_core_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_api_core_v1_event_proto_goTypes = []interface{}{
-	(*PatchData)(nil),  // 0: api.core.v1.PatchData
-	(*PatchDatas)(nil), // 1: api.core.v1.PatchDatas
-	(*SystemData)(nil), // 2: api.core.v1.SystemData
-	(*ProtoEvent)(nil), // 3: api.core.v1.ProtoEvent
-	nil,                // 4: api.core.v1.ProtoEvent.MetadataEntry
+	(*PatchData)(nil),   // 0: api.core.v1.PatchData
+	(*PatchDatas)(nil),  // 1: api.core.v1.PatchDatas
+	(*SystemData)(nil),  // 2: api.core.v1.SystemData
+	(*ProtoEvent)(nil),  // 3: api.core.v1.ProtoEvent
+	(*ProtoEvents)(nil), // 4: api.core.v1.ProtoEvents
+	nil,                 // 5: api.core.v1.ProtoEvent.MetadataEntry
 }
 var file_api_core_v1_event_proto_depIdxs = []int32{
 	0, // 0: api.core.v1.PatchDatas.patches:type_name -> api.core.v1.PatchData
-	4, // 1: api.core.v1.ProtoEvent.metadata:type_name -> api.core.v1.ProtoEvent.MetadataEntry
+	5, // 1: api.core.v1.ProtoEvent.metadata:type_name -> api.core.v1.ProtoEvent.MetadataEntry
 	1, // 2: api.core.v1.ProtoEvent.patches:type_name -> api.core.v1.PatchDatas
 	2, // 3: api.core.v1.ProtoEvent.system_data:type_name -> api.core.v1.SystemData
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	4, // 4: api.core.v1.ProtoEvent.batch:type_name -> api.core.v1.ProtoEvents
+	3, // 5: api.core.v1.ProtoEvents.events:type_name -> api.core.v1.ProtoEvent
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_api_core_v1_event_proto_init() }
@@ -453,11 +536,24 @@ func file_api_core_v1_event_proto_init() {
 				return nil
 			}
 		}
+		file_api_core_v1_event_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoEvents); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_api_core_v1_event_proto_msgTypes[3].OneofWrappers = []interface{}{
 		(*ProtoEvent_RawData)(nil),
 		(*ProtoEvent_Patches)(nil),
 		(*ProtoEvent_SystemData)(nil),
+		(*ProtoEvent_Batch)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -465,7 +561,7 @@ func file_api_core_v1_event_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_core_v1_event_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -27,6 +27,18 @@ const (
 	MetaResponseStatus  = "x-msg-response-status"
 	MetaResponseErrCode = "x-msg-response-errcode"
 	MetaPathConstructor = "x-msg-path-constructor"
+	MetaExpectedVersion = "x-msg-expected-version"
+	MetaTemplateID      = "x-msg-template-id"
+	MetaJobID           = "x-msg-job-id"
+	MetaTxID            = "x-msg-tx-id"
+	MetaTxPhase         = "x-msg-tx-phase"
+
+	// dead letter failure metadata.
+	MetaDeadLetterID        = "x-msg-dl-id"
+	MetaDeadLetterError     = "x-msg-dl-error"
+	MetaDeadLetterRuntime   = "x-msg-dl-runtime"
+	MetaDeadLetterAttempts  = "x-msg-dl-attempts"
+	MetaDeadLetterTimestamp = "x-msg-dl-timestamp"
 )
 
 type PathConstructor string 
@@ -38,6 +50,17 @@ const (
 
 
 
+// TxPhase phase of transaction event.
+type TxPhase string
+
+const (
+	TxPrepare TxPhase = "prepare"
+	TxCommit  TxPhase = "commit"
+	TxAbort   TxPhase = "abort"
+	// resolve the decision of transaction which participant locked too long.
+	TxResolve TxPhase = "resolve"
+)
+
 type EventType string
 
 const (
@@ -45,6 +68,8 @@ const (
 	ETEntity   EventType = "core.event.Entity"
 	ETSystem   EventType = "core.event.System"
 	ETCallback EventType = "core.event.Callback"
+	// ETBatch events of entities owned by the same runtime.
+	ETBatch EventType = "core.event.Batch"
 )
 
 type SystemOp string
@@ -52,6 +77,8 @@ type SystemOp string
 const (
 	OpCreate SystemOp = "core.event.System.Create"
 	OpDelete SystemOp = "core.event.System.Delete"
+	// OpTick timer of runtime, delivered to the runtime itself.
+	OpTick SystemOp = "core.event.System.Tick"
 )
 
 type Attribution interface {
@@ -191,3 +218,14 @@ type SystemEvent interface {
 func (e *ProtoEvent) Action() *SystemData {
 	return e.GetSystemData()
 }
+
+//-----------------------------------------------
+
+type BatchEvent interface {
+	Event
+	Events() []*ProtoEvent
+}
+
+func (e *ProtoEvent) Events() []*ProtoEvent {
+	return e.GetBatch().GetEvents()
+}
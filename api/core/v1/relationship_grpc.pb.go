@@ -0,0 +1,281 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// RelationshipClient is the client API for Relationship service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RelationshipClient interface {
+	CreateRelationship(ctx context.Context, in *CreateRelationshipRequest, opts ...grpc.CallOption) (*RelationshipObject, error)
+	UpdateRelationship(ctx context.Context, in *UpdateRelationshipRequest, opts ...grpc.CallOption) (*RelationshipObject, error)
+	GetRelationship(ctx context.Context, in *GetRelationshipRequest, opts ...grpc.CallOption) (*RelationshipObject, error)
+	DeleteRelationship(ctx context.Context, in *DeleteRelationshipRequest, opts ...grpc.CallOption) (*DeleteRelationshipResponse, error)
+	ListRelationship(ctx context.Context, in *ListRelationshipRequest, opts ...grpc.CallOption) (*ListRelationshipResponse, error)
+	TraverseRelationship(ctx context.Context, in *TraverseRelationshipRequest, opts ...grpc.CallOption) (*TraverseRelationshipResponse, error)
+}
+
+type relationshipClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRelationshipClient(cc grpc.ClientConnInterface) RelationshipClient {
+	return &relationshipClient{cc}
+}
+
+func (c *relationshipClient) CreateRelationship(ctx context.Context, in *CreateRelationshipRequest, opts ...grpc.CallOption) (*RelationshipObject, error) {
+	out := new(RelationshipObject)
+	err := c.cc.Invoke(ctx, "/api.core.v1.Relationship/CreateRelationship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationshipClient) UpdateRelationship(ctx context.Context, in *UpdateRelationshipRequest, opts ...grpc.CallOption) (*RelationshipObject, error) {
+	out := new(RelationshipObject)
+	err := c.cc.Invoke(ctx, "/api.core.v1.Relationship/UpdateRelationship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationshipClient) GetRelationship(ctx context.Context, in *GetRelationshipRequest, opts ...grpc.CallOption) (*RelationshipObject, error) {
+	out := new(RelationshipObject)
+	err := c.cc.Invoke(ctx, "/api.core.v1.Relationship/GetRelationship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationshipClient) DeleteRelationship(ctx context.Context, in *DeleteRelationshipRequest, opts ...grpc.CallOption) (*DeleteRelationshipResponse, error) {
+	out := new(DeleteRelationshipResponse)
+	err := c.cc.Invoke(ctx, "/api.core.v1.Relationship/DeleteRelationship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationshipClient) ListRelationship(ctx context.Context, in *ListRelationshipRequest, opts ...grpc.CallOption) (*ListRelationshipResponse, error) {
+	out := new(ListRelationshipResponse)
+	err := c.cc.Invoke(ctx, "/api.core.v1.Relationship/ListRelationship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationshipClient) TraverseRelationship(ctx context.Context, in *TraverseRelationshipRequest, opts ...grpc.CallOption) (*TraverseRelationshipResponse, error) {
+	out := new(TraverseRelationshipResponse)
+	err := c.cc.Invoke(ctx, "/api.core.v1.Relationship/TraverseRelationship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RelationshipServer is the server API for Relationship service.
+// All implementations must embed UnimplementedRelationshipServer
+// for forward compatibility
+type RelationshipServer interface {
+	CreateRelationship(context.Context, *CreateRelationshipRequest) (*RelationshipObject, error)
+	UpdateRelationship(context.Context, *UpdateRelationshipRequest) (*RelationshipObject, error)
+	GetRelationship(context.Context, *GetRelationshipRequest) (*RelationshipObject, error)
+	DeleteRelationship(context.Context, *DeleteRelationshipRequest) (*DeleteRelationshipResponse, error)
+	ListRelationship(context.Context, *ListRelationshipRequest) (*ListRelationshipResponse, error)
+	TraverseRelationship(context.Context, *TraverseRelationshipRequest) (*TraverseRelationshipResponse, error)
+	mustEmbedUnimplementedRelationshipServer()
+}
+
+// UnimplementedRelationshipServer must be embedded to have forward compatible implementations.
+type UnimplementedRelationshipServer struct {
+}
+
+func (UnimplementedRelationshipServer) CreateRelationship(context.Context, *CreateRelationshipRequest) (*RelationshipObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRelationship not implemented")
+}
+func (UnimplementedRelationshipServer) UpdateRelationship(context.Context, *UpdateRelationshipRequest) (*RelationshipObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRelationship not implemented")
+}
+func (UnimplementedRelationshipServer) GetRelationship(context.Context, *GetRelationshipRequest) (*RelationshipObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelationship not implemented")
+}
+func (UnimplementedRelationshipServer) DeleteRelationship(context.Context, *DeleteRelationshipRequest) (*DeleteRelationshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRelationship not implemented")
+}
+func (UnimplementedRelationshipServer) ListRelationship(context.Context, *ListRelationshipRequest) (*ListRelationshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRelationship not implemented")
+}
+func (UnimplementedRelationshipServer) TraverseRelationship(context.Context, *TraverseRelationshipRequest) (*TraverseRelationshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraverseRelationship not implemented")
+}
+func (UnimplementedRelationshipServer) mustEmbedUnimplementedRelationshipServer() {}
+
+// UnsafeRelationshipServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RelationshipServer will
+// result in compilation errors.
+type UnsafeRelationshipServer interface {
+	mustEmbedUnimplementedRelationshipServer()
+}
+
+func RegisterRelationshipServer(s grpc.ServiceRegistrar, srv RelationshipServer) {
+	s.RegisterService(&Relationship_ServiceDesc, srv)
+}
+
+func _Relationship_CreateRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationshipServer).CreateRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.core.v1.Relationship/CreateRelationship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationshipServer).CreateRelationship(ctx, req.(*CreateRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationship_UpdateRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationshipServer).UpdateRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.core.v1.Relationship/UpdateRelationship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationshipServer).UpdateRelationship(ctx, req.(*UpdateRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationship_GetRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationshipServer).GetRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.core.v1.Relationship/GetRelationship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationshipServer).GetRelationship(ctx, req.(*GetRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationship_DeleteRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationshipServer).DeleteRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.core.v1.Relationship/DeleteRelationship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationshipServer).DeleteRelationship(ctx, req.(*DeleteRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationship_ListRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationshipServer).ListRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.core.v1.Relationship/ListRelationship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationshipServer).ListRelationship(ctx, req.(*ListRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationship_TraverseRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraverseRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationshipServer).TraverseRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.core.v1.Relationship/TraverseRelationship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationshipServer).TraverseRelationship(ctx, req.(*TraverseRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Relationship_ServiceDesc is the grpc.ServiceDesc for Relationship service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Relationship_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.core.v1.Relationship",
+	HandlerType: (*RelationshipServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRelationship",
+			Handler:    _Relationship_CreateRelationship_Handler,
+		},
+		{
+			MethodName: "UpdateRelationship",
+			Handler:    _Relationship_UpdateRelationship_Handler,
+		},
+		{
+			MethodName: "GetRelationship",
+			Handler:    _Relationship_GetRelationship_Handler,
+		},
+		{
+			MethodName: "DeleteRelationship",
+			Handler:    _Relationship_DeleteRelationship_Handler,
+		},
+		{
+			MethodName: "ListRelationship",
+			Handler:    _Relationship_ListRelationship_Handler,
+		},
+		{
+			MethodName: "TraverseRelationship",
+			Handler:    _Relationship_TraverseRelationship_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/core/v1/relationship.proto",
+}
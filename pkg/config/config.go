@@ -46,14 +46,29 @@ type Configuration struct {
 	Discovery  Discovery      `yaml:"discovery" mapstructure:"discovery"`
 	Components Components     `yaml:"components" mapstructure:"components"`
 	Dispatcher DispatchConfig `yaml:"dispatcher" mapstructure:"dispatcher"`
+	Stages     []StageConfig  `yaml:"stages" mapstructure:"stages"`
 }
 
 type Server struct {
-	Name     string   `yaml:"name" mapstructure:"name"`
-	AppID    string   `yaml:"app_id" mapstructure:"app_id"`
-	HTTPAddr string   `yaml:"http_addr" mapstructure:"http_addr"`
-	GRPCAddr string   `yaml:"grpc_addr" mapstructure:"grpc_addr"`
-	Sources  []string `yaml:"sources" mapstructure:"sources"`
+	Name           string   `yaml:"name" mapstructure:"name"`
+	AppID          string   `yaml:"app_id" mapstructure:"app_id"`
+	HTTPAddr       string   `yaml:"http_addr" mapstructure:"http_addr"`
+	GRPCAddr       string   `yaml:"grpc_addr" mapstructure:"grpc_addr"`
+	Sources        []string `yaml:"sources" mapstructure:"sources"`
+	ResidencyLimit int      `yaml:"residency_limit" mapstructure:"residency_limit"`
+	DeadLetterSink string   `yaml:"dead_letter_sink" mapstructure:"dead_letter_sink"`
+	MaxEventHops   int      `yaml:"max_event_hops" mapstructure:"max_event_hops"`
+	HistoryLimit   int      `yaml:"history_limit" mapstructure:"history_limit"`
+	NodeName       string   `yaml:"node_name" mapstructure:"node_name"`
+	OwnerTTL       int      `yaml:"owner_ttl" mapstructure:"owner_ttl"`
+	// HistoryTypes entity types of which change histories recorded, "*" for all types.
+	HistoryTypes []string `yaml:"history_types" mapstructure:"history_types"`
+	// HistoryRetention days histories of deleted entities kept.
+	HistoryRetention int `yaml:"history_retention" mapstructure:"history_retention"`
+	// ShutdownTimeout seconds within which runtimes drained on shutdown.
+	ShutdownTimeout int `yaml:"shutdown_timeout" mapstructure:"shutdown_timeout"`
+	// PersistWindow milliseconds within which entity writes coalesced.
+	PersistWindow int `yaml:"persist_window" mapstructure:"persist_window"`
 }
 
 type Proxy struct {
@@ -78,6 +93,19 @@ type Metadata struct {
 	Properties []Pair `yaml:"properties"`
 }
 
+// StageConfig custom stage of entity event handling.
+type StageConfig struct {
+	Name        string   `yaml:"name" mapstructure:"name"`
+	Handler     string   `yaml:"handler" mapstructure:"handler"`
+	Phase       string   `yaml:"phase" mapstructure:"phase"`
+	Order       int      `yaml:"order" mapstructure:"order"`
+	EntityTypes []string `yaml:"entity_types" mapstructure:"entity_types"`
+	// Timeout milliseconds of each handling.
+	Timeout    int    `yaml:"timeout" mapstructure:"timeout"`
+	Optional   bool   `yaml:"optional" mapstructure:"optional"`
+	Properties []Pair `yaml:"properties" mapstructure:"properties"`
+}
+
 type EtcdConfig struct {
 	Endpoints   []string `yaml:"endpoints" mapstructure:"endpoints"`
 	DialTimeout int64    `yaml:"dial_timeout" mapstructure:"dial_timeout"`
@@ -120,7 +148,7 @@ func Init(cfgFile string) {
 	}
 
 	if err := viper.ReadInConfig(); nil != err {
-		if _, ok := err.(viper.ConfigFileNotFoundError); ok || errors.As(err, &fs.ErrNotExist) { //nolint
+		if _, ok := err.(viper.ConfigFileNotFoundError); ok || errors.Is(err, fs.ErrNotExist) {
 			// Config file not found.
 			defer writeDefault(cfgFile)
 		} else {
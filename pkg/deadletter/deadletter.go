@@ -0,0 +1,253 @@
+package deadletter
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/core/pkg/resource/pubsub"
+	"github.com/tkeel-io/core/pkg/util"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/zap"
+)
+
+// Letter event failed processing.
+type Letter struct {
+	RuntimeID string
+	// Event failed event, nil if message undecodable.
+	Event v1.Event
+	// Raw undecodable message.
+	Raw      []byte
+	Err      error
+	Attempts int64
+}
+
+const (
+	// dead letters expired after ttl.
+	letterTTL = 7 * 24 * time.Hour
+	// dead letters put within the period share one lease.
+	leasePeriod = time.Hour
+	// max dead letters kept per runtime, letters pushed then dropped until expired or replayed.
+	maxLetters = 10000
+	// max size of event kept with dead letter, larger events kept by sink only.
+	maxEventSize = 64 << 10
+)
+
+type Queue interface {
+	// Push persist failed event, the event kept by sink only if forwarded.
+	Push(context.Context, *Letter) error
+	// List returns dead letters after {runtimeID}/{id}, all runtimes if runtimeID empty.
+	List(ctx context.Context, runtimeID, after string, limit int64) ([]dao.DeadLetter, error)
+	// Replay redeliver dead letter event with dispatch, remove it if dispatched.
+	Replay(ctx context.Context, runtimeID, id string, dispatch pubsub.EventHandler) error
+	// Close release sink of queue.
+	Close() error
+}
+
+var (
+	globalLock  sync.RWMutex
+	globalQueue Queue = &noopQueue{}
+)
+
+// Initialize global dead letter queue, sink is pubsub url, optional.
+func Initialize(repo repository.IRepository, sink string) {
+	globalLock.Lock()
+	defer globalLock.Unlock()
+	globalQueue = New(repo, sink)
+}
+
+func Global() Queue {
+	globalLock.RLock()
+	defer globalLock.RUnlock()
+	return globalQueue
+}
+
+type queue struct {
+	sink       pubsub.Pubsub
+	sinkURL    string
+	repository repository.IRepository
+
+	lock    sync.Mutex
+	lease   int64
+	leaseAt time.Time
+}
+
+func New(repo repository.IRepository, sink string) Queue {
+	q := &queue{repository: repo, sinkURL: sink}
+	if sink != "" {
+		q.sink = pubsub.NewPubsub("", sink)
+	}
+	return q
+}
+
+// expiry returns lease of dead letters, letters expired within leasePeriod before letterTTL.
+func (q *queue) expiry(ctx context.Context) (int64, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if q.lease > 0 && time.Since(q.leaseAt) < leasePeriod {
+		return q.lease, nil
+	}
+
+	lease, err := q.repository.GrantExpiry(ctx, int64(letterTTL/time.Second))
+	if nil != err {
+		return 0, errors.Wrap(err, "grant dead letter expiry")
+	}
+	q.lease, q.leaseAt = lease, time.Now()
+	return lease, nil
+}
+
+func (q *queue) Push(ctx context.Context, letter *Letter) error {
+	var (
+		err   error
+		bytes []byte
+		cause string
+	)
+
+	if nil != letter.Err {
+		cause = letter.Err.Error()
+	}
+
+	ev := letter.Event
+	if nil == ev {
+		ev = &v1.ProtoEvent{
+			Id:       util.UUID("ev"),
+			Metadata: map[string]string{},
+			Data:     &v1.ProtoEvent_RawData{RawData: letter.Raw},
+		}
+	}
+
+	if bytes, err = v1.Marshal(ev); nil != err {
+		return errors.Wrap(err, "encode dead letter event")
+	}
+
+	dl := &dao.DeadLetter{
+		ID:        util.UUID("dl"),
+		RuntimeID: letter.RuntimeID,
+		Error:     cause,
+		Attempts:  letter.Attempts,
+		Timestamp: time.Now().UnixNano(),
+		Event:     bytes,
+		EventSize: len(bytes),
+	}
+
+	log.L().Warn("push dead letter", zfield.ID(dl.ID), zfield.Eid(ev.Entity()),
+		zfield.Reason(cause), zap.String("runtime", dl.RuntimeID), zap.Int64("attempts", dl.Attempts))
+
+	// events forwarded kept by sink, the dead letter refers to it.
+	if nil != q.sink {
+		if err = q.forward(ctx, dl); nil != err {
+			log.L().Error("forward dead letter to sink", zap.Error(err), zfield.ID(dl.ID))
+		} else {
+			dl.Event, dl.Sink = nil, q.sinkURL
+		}
+	}
+
+	// dead letters stored in etcd bounded by count and size.
+	count, err := q.repository.CountDeadLetter(ctx, dl.RuntimeID)
+	if nil != err {
+		return errors.Wrap(err, "push dead letter")
+	} else if count >= maxLetters {
+		return errors.Wrapf(xerrors.ErrDeadLetterFull, "push dead letter, %d dead letters of runtime %s", count, dl.RuntimeID)
+	} else if len(dl.Event) > maxEventSize {
+		log.L().Warn("push dead letter, event too large, dropped", zfield.ID(dl.ID),
+			zfield.Eid(ev.Entity()), zap.Int("size", dl.EventSize))
+		dl.Event, dl.Dropped = nil, true
+	}
+
+	lease, err := q.expiry(ctx)
+	if nil != err {
+		return errors.Wrap(err, "push dead letter")
+	} else if err = q.repository.PutDeadLetter(ctx, dl, lease); nil != err {
+		return errors.Wrap(err, "push dead letter")
+	}
+
+	return nil
+}
+
+// forward send event of dead letter to sink, with failure metadata.
+func (q *queue) forward(ctx context.Context, dl *dao.DeadLetter) error {
+	// decode a copy, keep original event untouched.
+	sinkEv := &v1.ProtoEvent{}
+	if err := v1.Unmarshal(dl.Event, sinkEv); nil != err {
+		return errors.Wrap(err, "decode dead letter event")
+	} else if nil == sinkEv.Metadata {
+		sinkEv.Metadata = map[string]string{}
+	}
+	sinkEv.SetAttr(v1.MetaDeadLetterID, dl.ID)
+	sinkEv.SetAttr(v1.MetaDeadLetterError, dl.Error)
+	sinkEv.SetAttr(v1.MetaDeadLetterRuntime, dl.RuntimeID)
+	sinkEv.SetAttr(v1.MetaDeadLetterAttempts, strconv.FormatInt(dl.Attempts, 10))
+	sinkEv.SetAttr(v1.MetaDeadLetterTimestamp, strconv.FormatInt(dl.Timestamp, 10))
+	return errors.Wrap(q.sink.Send(ctx, sinkEv), "send dead letter event")
+}
+
+func (q *queue) List(ctx context.Context, runtimeID, after string, limit int64) ([]dao.DeadLetter, error) {
+	letters, err := q.repository.ListDeadLetter(ctx,
+		&dao.ListDeadLetterReq{RuntimeID: runtimeID, After: after, Limit: limit})
+	return letters, errors.Wrap(err, "list dead letter")
+}
+
+func (q *queue) Replay(ctx context.Context, runtimeID, id string, dispatch pubsub.EventHandler) error {
+	dl, err := q.repository.GetDeadLetter(ctx, &dao.DeadLetter{ID: id, RuntimeID: runtimeID})
+	if nil != err {
+		return errors.Wrap(err, "replay dead letter")
+	}
+
+	var ev v1.ProtoEvent
+	if dl.Sink != "" {
+		// event kept by sink only.
+		return errors.Wrapf(xerrors.ErrInvalidParam, "replay dead letter, event forwarded to sink %s", dl.Sink)
+	} else if dl.Dropped {
+		return errors.Wrapf(xerrors.ErrInvalidParam, "replay dead letter, event of %d bytes dropped", dl.EventSize)
+	} else if err = v1.Unmarshal(dl.Event, &ev); nil != err {
+		return errors.Wrap(err, "replay dead letter, decode event")
+	} else if ev.Entity() == "" {
+		// raw message which undecodable.
+		return errors.Wrap(xerrors.ErrInvalidParam, "replay dead letter, event entity empty")
+	} else if err = dispatch(ctx, &ev); nil != err {
+		return errors.Wrap(err, "replay dead letter, dispatch event")
+	}
+
+	log.L().Info("replay dead letter", zfield.ID(dl.ID),
+		zfield.Eid(ev.Entity()), zap.String("runtime", dl.RuntimeID))
+	return errors.Wrap(q.repository.DelDeadLetter(ctx, dl), "replay dead letter")
+}
+
+func (q *queue) Close() error {
+	if nil == q.sink {
+		return nil
+	}
+	return errors.Wrap(q.sink.Close(), "close dead letter sink")
+}
+
+// noopQueue log dead letters before queue initialized.
+type noopQueue struct{}
+
+func (q *noopQueue) Push(ctx context.Context, letter *Letter) error {
+	var eid string
+	if nil != letter.Event {
+		eid = letter.Event.Entity()
+	}
+	log.L().Error("drop dead letter, dead letter queue not initialized", zap.Error(letter.Err),
+		zfield.Eid(eid), zap.String("runtime", letter.RuntimeID), zap.Int64("attempts", letter.Attempts))
+	return nil
+}
+
+func (q *noopQueue) List(ctx context.Context, runtimeID, after string, limit int64) ([]dao.DeadLetter, error) {
+	return nil, xerrors.ErrServerNotReady
+}
+
+func (q *noopQueue) Replay(ctx context.Context, runtimeID, id string, dispatch pubsub.EventHandler) error {
+	return xerrors.ErrServerNotReady
+}
+
+func (q *noopQueue) Close() error {
+	return nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletter_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/deadletter"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/core/pkg/runtime/mock"
+)
+
+func TestQueue_PushReplay(t *testing.T) {
+	repo := mock.NewRepo()
+	q := deadletter.New(repo, "")
+
+	ev := &v1.ProtoEvent{Id: "ev-1", Metadata: map[string]string{}}
+	ev.SetEntity("device123")
+	assert.Nil(t, q.Push(context.TODO(), &deadletter.Letter{
+		RuntimeID: "core-1", Event: ev, Err: errors.New("handle failed"), Attempts: 3}))
+	assert.Nil(t, q.Push(context.TODO(), &deadletter.Letter{
+		RuntimeID: "core-2", Raw: []byte("invalid"), Err: errors.New("decode failed"), Attempts: 1}))
+
+	letters, err := q.List(context.TODO(), "core-1", "", 0)
+	assert.Nil(t, err)
+	assert.Len(t, letters, 1)
+	assert.Equal(t, "handle failed", letters[0].Error)
+	assert.Equal(t, int64(3), letters[0].Attempts)
+
+	var replayed v1.Event
+	dispatch := func(_ context.Context, ev v1.Event) error {
+		replayed = ev
+		return nil
+	}
+
+	assert.Nil(t, q.Replay(context.TODO(), "core-1", letters[0].ID, dispatch))
+	assert.Equal(t, "device123", replayed.Entity())
+	assert.Equal(t, "ev-1", replayed.ID())
+	_, err = repo.GetDeadLetter(context.TODO(), &letters[0])
+	assert.ErrorIs(t, err, xerrors.ErrDeadLetterNotFound)
+
+	// undecodable message can not be replayed.
+	letters, _ = q.List(context.TODO(), "core-2", "", 0)
+	assert.Len(t, letters, 1)
+	assert.ErrorIs(t, q.Replay(context.TODO(), "core-2", letters[0].ID, dispatch), xerrors.ErrInvalidParam)
+}
+
+func TestQueue_List(t *testing.T) {
+	repo := mock.NewRepo()
+	q := deadletter.New(repo, "")
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, q.Push(context.TODO(), &deadletter.Letter{
+			RuntimeID: "core-1", Raw: []byte("invalid"), Err: errors.New("decode failed"), Attempts: 1}))
+	}
+
+	// listed in pages.
+	letters, err := q.List(context.TODO(), "", "", 2)
+	assert.Nil(t, err)
+	assert.Len(t, letters, 2)
+	last := letters[1]
+	letters, err = q.List(context.TODO(), "", last.RuntimeID+"/"+last.ID, 2)
+	assert.Nil(t, err)
+	assert.Len(t, letters, 1)
+	assert.Greater(t, letters[0].ID, last.ID)
+}
+
+func TestQueue_PushLimited(t *testing.T) {
+	repo := mock.NewRepo()
+	q := deadletter.New(repo, "")
+
+	// large event dropped, metadata kept.
+	assert.Nil(t, q.Push(context.TODO(), &deadletter.Letter{
+		RuntimeID: "core-1", Raw: make([]byte, 128<<10), Err: errors.New("decode failed"), Attempts: 1}))
+	letters, err := q.List(context.TODO(), "core-1", "", 0)
+	assert.Nil(t, err)
+	assert.Len(t, letters, 1)
+	assert.True(t, letters[0].Dropped)
+	assert.Empty(t, letters[0].Event)
+	assert.Greater(t, letters[0].EventSize, 128<<10)
+	assert.ErrorIs(t, q.Replay(context.TODO(), "core-1", letters[0].ID, nil), xerrors.ErrInvalidParam)
+
+	// dead letters of runtime full.
+	for i := 0; i < 10000; i++ {
+		dl := dao.DeadLetter{ID: fmt.Sprintf("dl-%d", i), RuntimeID: "core-2"}
+		repo.DeadLetters[dl.Key()] = dl
+	}
+	err = q.Push(context.TODO(), &deadletter.Letter{
+		RuntimeID: "core-2", Raw: []byte("invalid"), Err: errors.New("decode failed"), Attempts: 1})
+	assert.ErrorIs(t, err, xerrors.ErrDeadLetterFull)
+	assert.Nil(t, q.Push(context.TODO(), &deadletter.Letter{
+		RuntimeID: "core-1", Raw: []byte("invalid"), Err: errors.New("decode failed"), Attempts: 1}))
+}
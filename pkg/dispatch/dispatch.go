@@ -3,10 +3,12 @@ package dispatch
 import (
 	"context"
 	"net/http"
+	"sync"
 
 	"github.com/pkg/errors"
 	v1 "github.com/tkeel-io/core/api/core/v1"
 	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
 	zfield "github.com/tkeel-io/core/pkg/logger"
 	"github.com/tkeel-io/core/pkg/placement"
 	"github.com/tkeel-io/core/pkg/resource/pubsub"
@@ -14,6 +16,7 @@ import (
 	xkafka "github.com/tkeel-io/core/pkg/util/kafka"
 	"github.com/tkeel-io/core/pkg/util/transport"
 	"github.com/tkeel-io/kit/log"
+	"go.uber.org/zap"
 )
 
 func New(ctx context.Context) *dispatcher { //nolint
@@ -35,6 +38,8 @@ type dispatcher struct {
 	transmitter transport.Transmitter
 	upstreams   map[string]pubsub.Pubsub
 	downstreams map[string]*xkafka.Pubsub
+
+	lock sync.RWMutex
 }
 
 func (d *dispatcher) Dispatch(ctx context.Context, ev v1.Event) error {
@@ -75,7 +80,13 @@ func (d *dispatcher) Start(ctx context.Context, cfg config.DispatchConfig) error
 func (d *dispatcher) dispatch(ctx context.Context, ev v1.Event) error {
 	eid := ev.Entity()
 	info := placement.Global().Select(eid)
-	err := d.downstreams[info.ID].Send(ctx, ev)
+	d.lock.RLock()
+	downstream, has := d.downstreams[info.ID]
+	d.lock.RUnlock()
+	if !has {
+		return errors.Wrapf(xerrors.ErrQueueNotFound, "dispatch event, entity %s", eid)
+	}
+	err := downstream.Send(ctx, ev)
 	return errors.Wrap(err, "dispatch event")
 }
 
@@ -97,12 +108,67 @@ func (d *dispatcher) initUpstream(ctx context.Context, streams []string) error {
 
 func (d *dispatcher) initDownstream(ctx context.Context, streams []string) error {
 	for _, stream := range streams {
-		streamIns, err := xkafka.NewKafkaPubsub(stream)
-		if nil != err {
-			return errors.Wrap(err, "create sink instance")
+		if _, err := d.AppendDownstream(stream); nil != err {
+			return errors.Wrap(err, "init downstream")
 		}
-		d.downstreams[streamIns.ID()] = streamIns
-		placement.Global().Append(placement.Info{ID: streamIns.ID()})
 	}
 	return nil
 }
+
+// AppendDownstream add downstream queue, returns id of the queue.
+func (d *dispatcher) AppendDownstream(stream string) (string, error) {
+	streamIns, err := xkafka.NewKafkaPubsub(stream)
+	if nil != err {
+		return "", errors.Wrap(err, "create sink instance")
+	}
+
+	d.lock.Lock()
+	prev, has := d.downstreams[streamIns.ID()]
+	d.downstreams[streamIns.ID()] = streamIns
+	d.lock.Unlock()
+	if has {
+		prev.Close()
+	}
+
+	placement.Global().Append(placement.Info{ID: streamIns.ID(), Weight: streamIns.Weight()})
+	log.L().Info("append downstream", zfield.ID(streamIns.ID()), zfield.URL(stream))
+	return streamIns.ID(), nil
+}
+
+// RemoveDownstream remove downstream queue, entities placed to other queues.
+func (d *dispatcher) RemoveDownstream(id string) {
+	placement.Global().Remove(placement.Info{ID: id})
+	d.lock.Lock()
+	streamIns, has := d.downstreams[id]
+	delete(d.downstreams, id)
+	d.lock.Unlock()
+	if has {
+		streamIns.Close()
+		log.L().Info("remove downstream", zfield.ID(id))
+	}
+}
+
+// Close stop upstreams and release pubsubs, events not dispatched any more.
+func (d *dispatcher) Close() error {
+	log.L().Info("close dispatcher", zfield.ID(d.id))
+	d.cancel()
+
+	var err error
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	for id, stream := range d.upstreams {
+		if innerErr := stream.Close(); nil != innerErr {
+			log.L().Warn("close upstream", zap.Error(innerErr), zfield.ID(id))
+			err = innerErr
+		}
+	}
+	for id, stream := range d.downstreams {
+		if innerErr := stream.Close(); nil != innerErr {
+			log.L().Warn("close downstream", zap.Error(innerErr), zfield.ID(id))
+			err = innerErr
+		}
+	}
+	d.upstreams = make(map[string]pubsub.Pubsub)
+	d.downstreams = make(map[string]*xkafka.Pubsub)
+	return errors.Wrap(err, "close dispatcher")
+}
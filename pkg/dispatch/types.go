@@ -9,3 +9,14 @@ import (
 type Dispatcher interface {
 	Dispatch(context.Context, v1.Event) error
 }
+
+// Downstreams manage downstream queues of dispatcher.
+type Downstreams interface {
+	AppendDownstream(string) (string, error)
+	RemoveDownstream(string)
+}
+
+// Closer release pubsubs of dispatcher.
+type Closer interface {
+	Close() error
+}
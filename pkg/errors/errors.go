@@ -1,6 +1,10 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
 
 var (
 	ErrInvalidJSONPath          = errors.New("Core.JSON.Path.Invalid")
@@ -9,10 +13,27 @@ var (
 	ErrInternal                 = errors.New("Core.Internal")
 	ErrEntityNotFound           = errors.New("Core.Entity.NotFound")
 	ErrEntityAleadyExists       = errors.New("Core.Entity.Already.Exists")
+	ErrEntityVersionConflict    = errors.New("Core.Entity.Version.Conflict")
+	ErrEntityLocked             = errors.New("Core.Entity.Locked")
+	ErrTransactionNotFound      = errors.New("Core.Transaction.NotFound")
+	ErrTransactionAborted       = errors.New("Core.Transaction.Aborted")
+	ErrTransactionIncomplete    = errors.New("Core.Transaction.Incomplete")
 	ErrInvalidEntityParams      = errors.New("Core.Entity.Params.Invalid")
 	ErrRuntimeNotExists         = errors.New("Core.Runtime.NotExists")
+	ErrRuntimeFenced            = errors.New("Core.Runtime.Fenced")
 	ErrMapperNotFound           = errors.New("Core.Mapper.NotFound")
+	ErrMapperCycle              = errors.New("Core.Mapper.Cycle")
 	ErrQueueNotFound            = errors.New("Core.Queue.NotFound")
+	ErrQueueAlreadyExists       = errors.New("Core.Queue.Already.Exists")
+	ErrSnapshotNotFound         = errors.New("Core.Snapshot.NotFound")
+	ErrEntitySnapshotNotFound   = errors.New("Core.Entity.Snapshot.NotFound")
+	ErrWatchTokenExpired        = errors.New("Core.Watch.Token.Expired")
+	ErrWatcherDropped           = errors.New("Core.Watch.Dropped")
+	ErrDeadLetterNotFound       = errors.New("Core.DeadLetter.NotFound")
+	ErrDeadLetterFull           = errors.New("Core.DeadLetter.Full")
+	ErrJobNotFound              = errors.New("Core.Job.NotFound")
+	ErrRelationshipNotFound     = errors.New("Core.Relationship.NotFound")
+	ErrRelationshipExists       = errors.New("Core.Relationship.AlreadyExists")
 	ErrNodeNotExist             = errors.New("Core.Cluster.Node.NotExist")
 	ErrInvalidQueueType         = errors.New("Core.Queue.Type.Invalid")
 	ErrInvalidQueueConsumerType = errors.New("Core.Queue.Consumer.Type.Invalid")
@@ -23,6 +44,7 @@ var (
 	ErrInvalidHTTPRequest       = errors.New("Core.Transport.Http.Request.Invalid")
 	ErrInvalidHTTPInited        = errors.New("Core.Transport.Http.Inited")
 	ErrTemplateNotFound         = errors.New("Core.Template.NotFound")
+	ErrTemplateCycle            = errors.New("Core.Template.Cycle")
 	ErrEntityPropertyIDEmpty    = errors.New("Core.Entity.PropertyID.Empty")
 	ErrInvalidRequest           = errors.New("Core.Request.Invalid")
 	ErrEntityConfigInvalid      = errors.New("invalid entity configurations")
@@ -34,11 +56,36 @@ var (
 	ErrPatchPathLack            = errors.New("patch path lack")
 	ErrPatchPathRoot            = errors.New("patch path lack root")
 	ErrPatchTypeInvalid         = errors.New("patch config type invalid")
+	ErrPatchTestFailed          = errors.New("Core.Entity.Patch.Test.Failed")
+	ErrSchemeViolated           = errors.New("Core.Entity.Scheme.Violated")
 	ErrServerNotReady           = errors.New("Core.Service.NotReady")
+	ErrEventHopsExceeded        = errors.New("Core.Event.Hops.Exceeded")
 	ErrConnectionNil            = errors.New("Core.Resource.Connection.Nil")
 	ErrInvalidParam             = errors.New("Core.Params.Invalid")
 )
 
+// responded errors which callers may match with errors.Is.
+var knownErrors = map[string]error{
+	ErrEntityVersionConflict.Error(): ErrEntityVersionConflict,
+	ErrEntityLocked.Error():          ErrEntityLocked,
+	ErrTransactionNotFound.Error():   ErrTransactionNotFound,
+	ErrPatchTestFailed.Error():       ErrPatchTestFailed,
+	ErrEventHopsExceeded.Error():     ErrEventHopsExceeded,
+	ErrSchemeViolated.Error():        ErrSchemeViolated,
+	ErrTemplateCycle.Error():         ErrTemplateCycle,
+	ErrTemplateNotFound.Error():      ErrTemplateNotFound,
+}
+
 func New(code string) error {
+	if err, ok := knownErrors[code]; ok {
+		return err
+	}
+
+	// known error with details, formatted as "code: details".
+	if index := strings.Index(code, ": "); index > 0 {
+		if err, ok := knownErrors[code[:index]]; ok {
+			return fmt.Errorf("%w%s", err, code[index:])
+		}
+	}
 	return errors.New(code)
 }
@@ -18,6 +18,8 @@ type Base struct {
 	LastTime   int64        `json:"last_time" msgpack:"last_time" mapstructure:"last_time"`
 	Mappers    []*v1.Mapper `json:"mappers" msgpack:"mappers" mapstructure:"mappers"`
 	TemplateID string       `json:"template_id" msgpack:"template_id" mapstructure:"template_id"`
+	Templates  []string     `json:"templates,omitempty" msgpack:"templates" mapstructure:"templates"`
+	SchemeMode string       `json:"scheme_mode,omitempty" msgpack:"scheme_mode" mapstructure:"scheme_mode"`
 	Scheme     []byte       `json:"-" msgpack:"scheme" mapstructure:"-"`
 	Properties []byte       `json:"properties" msgpack:"properties" mapstructure:"properties"`
 }
@@ -31,9 +33,13 @@ type BaseRet struct {
 	LastTime    int64                  `json:"last_time" msgpack:"last_time" mapstructure:"last_time"`
 	Mappers     []*v1.Mapper           `json:"mappers" msgpack:"mappers" mapstructure:"mappers"`
 	TemplateID  string                 `json:"template_id" msgpack:"template_id" mapstructure:"template_id"`
+	Templates   []string               `json:"templates" msgpack:"templates" mapstructure:"templates"`
+	SchemeMode  string                 `json:"scheme_mode" msgpack:"scheme_mode" mapstructure:"scheme_mode"`
 	Description string                 `json:"description" msgpack:"description" mapstructure:"description"`
 	Properties  map[string]interface{} `json:"properties" msgpack:"properties" mapstructure:"properties"`
 	Scheme      map[string]interface{} `json:"scheme" msgpack:"-" mapstructure:"scheme"`
+	// SchemeOrigins templates which inherited scheme entries come from.
+	SchemeOrigins map[string]string `json:"scheme_origins" msgpack:"-" mapstructure:"scheme_origins"`
 }
 
 func (b *Base) Basic() Base {
@@ -45,11 +51,13 @@ func (b *Base) Basic() Base {
 		Version:    b.Version,
 		LastTime:   b.LastTime,
 		TemplateID: b.TemplateID,
+		SchemeMode: b.SchemeMode,
 		Scheme:     []byte(`{}`),
 		Properties: []byte(`{}`),
 	}
 
 	cp.Mappers = append(cp.Mappers, b.Mappers...)
+	cp.Templates = append(cp.Templates, b.Templates...)
 	return cp
 }
 
@@ -62,6 +70,8 @@ func (b *Base) JSON() map[string]interface{} {
 	info["version"] = b.Version
 	info["last_time"] = b.LastTime
 	info["template_id"] = b.TemplateID
+	info["templates"] = b.Templates
+	info["scheme_mode"] = b.SchemeMode
 	info["scheme"] = string(b.Scheme)
 	info["properties"] = string(b.Properties)
 	return info
@@ -0,0 +1,158 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/core/pkg/manager/holder"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/types"
+	"github.com/tkeel-io/core/pkg/util"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/zap"
+)
+
+// max items of batch event, keeps message within the size limit of queue.
+const maxBatchEventItems = 100
+
+type BatchOp string
+
+const (
+	BatchCreate BatchOp = "create"
+	BatchPatch  BatchOp = "patch"
+	BatchDelete BatchOp = "delete"
+)
+
+// BatchItem operation of entity in batch.
+type BatchItem struct {
+	Op      BatchOp
+	Entity  *Base
+	Patches []*v1.PatchData
+	Opts    []Option
+}
+
+// BatchResult entity operated by batch, Ret is nil for deleted.
+type BatchResult struct {
+	Ret *BaseRet
+	Raw []byte
+	Err error
+}
+
+// BatchEntities dispatch items grouped by runtime owning the entities, one event for each group,
+// failures of items not affect others, results in the order of items.
+func (m *apiManager) BatchEntities(ctx context.Context, items []*BatchItem) []*BatchResult {
+	elapsedTime := util.NewElapsed()
+	results := make([]*BatchResult, len(items))
+	waiters := make([]*holder.Waiter, len(items))
+
+	// group events by runtime owning entity, in the order of items.
+	var queues []string
+	groups := make(map[string][]int)
+	events := make([]*v1.ProtoEvent, len(items))
+	for index, item := range items {
+		reqID := util.IG().ReqID()
+		ev, err := m.batchEvent(reqID, item)
+		if nil != err {
+			results[index] = &BatchResult{Err: errors.Wrap(err, "batch entities")}
+			continue
+		}
+
+		qid := placement.Global().Select(item.Entity.ID).ID
+		if _, has := groups[qid]; !has {
+			queues = append(queues, qid)
+		}
+
+		events[index] = ev
+		groups[qid] = append(groups[qid], index)
+		waiters[index] = m.holder.Wait(ctx, reqID)
+	}
+
+	for _, qid := range queues {
+		indexes := groups[qid]
+		for start := 0; start < len(indexes); start += maxBatchEventItems {
+			end := start + maxBatchEventItems
+			if end > len(indexes) {
+				end = len(indexes)
+			}
+
+			batch := make([]*v1.ProtoEvent, 0, end-start)
+			for _, index := range indexes[start:end] {
+				batch = append(batch, events[index])
+			}
+
+			if err := m.dispatcher.Dispatch(ctx, &v1.ProtoEvent{
+				Id:        util.IG().EvID(),
+				Timestamp: time.Now().UnixNano(),
+				Metadata: map[string]string{
+					v1.MetaType:     string(v1.ETBatch),
+					v1.MetaEntityID: items[indexes[start]].Entity.ID},
+				Data: &v1.ProtoEvent_Batch{
+					Batch: &v1.ProtoEvents{Events: batch}},
+			}); nil != err {
+				log.L().Error("batch entities, dispatch event",
+					zap.Error(err), zfield.ID(qid), zap.Int("items", len(batch)))
+				for _, index := range indexes[start:end] {
+					waiters[index].Cancel()
+					results[index] = &BatchResult{Err: errors.Wrap(err, "batch entities, dispatch event")}
+				}
+			}
+		}
+	}
+
+	// wait responses, requests responded concurrently by runtimes.
+	for index, waiter := range waiters {
+		if nil == waiter || nil != results[index] {
+			continue
+		}
+		results[index] = m.batchResult(ctx, items[index], waiter.Wait())
+	}
+
+	log.L().Info("processing completed", zap.Int("items", len(items)),
+		zap.Int("runtimes", len(queues)), zfield.Elapsed(elapsedTime.Elapsed()))
+	return results
+}
+
+// batchEvent returns event of item, responded to request reqID.
+func (m *apiManager) batchEvent(reqID string, item *BatchItem) (*v1.ProtoEvent, error) {
+	switch item.Op {
+	case BatchCreate:
+		m.checkParams(item.Entity)
+		return m.createEvent(reqID, item.Entity)
+	case BatchPatch:
+		return m.patchEvent(reqID, item.Entity, item.Patches, item.Opts...), nil
+	case BatchDelete:
+		return m.deleteEvent(reqID, item.Entity, item.Opts...), nil
+	}
+	return nil, errors.Wrapf(xerrors.ErrInvalidParam, "batch operation %s", item.Op)
+}
+
+// batchResult returns result of item from response.
+func (m *apiManager) batchResult(ctx context.Context, item *BatchItem, resp holder.Response) *BatchResult {
+	if resp.Status != types.StatusOK {
+		log.L().Error("batch entities", zfield.Eid(item.Entity.ID),
+			zap.String("op", string(item.Op)), zap.Error(xerrors.New(resp.ErrCode)))
+		return &BatchResult{Err: xerrors.New(resp.ErrCode)}
+	} else if BatchDelete == item.Op {
+		return &BatchResult{}
+	}
+
+	var baseRet BaseRet
+	if err := json.Unmarshal(resp.Data, &baseRet); nil != err {
+		log.L().Error("batch entities, decode response", zap.Error(err),
+			zfield.Eid(item.Entity.ID), zfield.Entity(string(resp.Data)))
+		return &BatchResult{Err: errors.Wrap(err, "batch entities, decode response")}
+	} else if err = m.addMapper(ctx, &baseRet); nil != err {
+		log.L().Error("batch entities, decode response, list mapper",
+			zap.Error(err), zfield.Eid(item.Entity.ID))
+		if BatchCreate == item.Op {
+			return &BatchResult{Err: errors.Wrap(err, "batch entities, decode response, list mapper")}
+		}
+	}
+
+	return &BatchResult{Ret: &baseRet, Raw: resp.Data}
+}
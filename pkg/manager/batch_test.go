@@ -0,0 +1,75 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/manager/holder"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/runtime/mock"
+	"github.com/tkeel-io/core/pkg/types"
+)
+
+// batchResponder responds events of batch like runtimes.
+type batchResponder struct {
+	holder holder.Holder
+	events []v1.Event
+}
+
+func (d *batchResponder) Dispatch(_ context.Context, ev v1.Event) error {
+	d.events = append(d.events, ev)
+	for _, item := range ev.(v1.BatchEvent).Events() {
+		if item.Entity() == "device-failed" {
+			d.holder.OnRespond(&holder.Response{ID: item.Attr(v1.MetaRequestID),
+				Status: types.StatusError, ErrCode: "Core.Entity.NotFound"})
+			continue
+		}
+
+		data, _ := json.Marshal(&BaseRet{ID: item.Entity(), Owner: "admin"})
+		d.holder.OnRespond(&holder.Response{ID: item.Attr(v1.MetaRequestID), Status: types.StatusOK, Data: data})
+	}
+	return nil
+}
+
+func TestEntity_BatchEntities(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core-1", Flag: true})
+	placement.Global().Append(placement.Info{ID: "core-2", Flag: true})
+
+	dispatcher := &batchResponder{holder: holder.New(context.Background(), time.Second)}
+	m := &apiManager{entityRepo: mock.NewRepo(), dispatcher: dispatcher, holder: dispatcher.holder}
+
+	ids := []string{"device1", "device2", "device3", "device4", "device5", "device-failed"}
+	items := make([]*BatchItem, 0, len(ids)+1)
+	for _, id := range ids {
+		items = append(items, &BatchItem{Op: BatchPatch, Entity: &Base{ID: id, Owner: "admin"}})
+	}
+	items = append(items, &BatchItem{Op: BatchDelete, Entity: &Base{ID: "device1"}})
+
+	results := m.BatchEntities(context.Background(), items)
+	assert.Len(t, results, len(items))
+	for index, id := range ids[:5] {
+		assert.Nil(t, results[index].Err)
+		assert.Equal(t, id, results[index].Ret.ID)
+	}
+	assert.NotNil(t, results[5].Err)
+	assert.Nil(t, results[6].Err)
+	assert.Nil(t, results[6].Ret)
+
+	// one event for each runtime, items of event owned by the runtime.
+	assert.LessOrEqual(t, len(dispatcher.events), 2)
+	handled := 0
+	for _, ev := range dispatcher.events {
+		assert.Equal(t, v1.ETBatch, ev.Type())
+		queue := placement.Global().Select(ev.Entity()).ID
+		for _, item := range ev.(v1.BatchEvent).Events() {
+			assert.Equal(t, queue, placement.Global().Select(item.Entity()).ID)
+			handled++
+		}
+	}
+	assert.Equal(t, len(items), handled)
+}
@@ -0,0 +1,96 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+	"go.uber.org/zap"
+)
+
+const (
+	fieldVersion  = "version"
+	fieldLastTime = "last_time"
+	fieldOwner    = "owner"
+)
+
+// GetEntityAt rebuild entity from the latest snapshot and histories after it.
+func (m *apiManager) GetEntityAt(ctx context.Context, en *Base, version, timestamp int64) (*BaseRet, []byte, error) {
+	log.L().Info("entity.GetEntityAt", zfield.Eid(en.ID), zfield.Version(version),
+		zap.Int64("timestamp", timestamp), zfield.Owner(en.Owner), zfield.Source(en.Source))
+
+	snapshot, err := m.entityRepo.GetEntitySnapshot(ctx,
+		&dao.GetEntitySnapshotReq{EntityID: en.ID, Version: version, Timestamp: timestamp})
+	if nil != err {
+		log.L().Error("get entity at, get snapshot", zap.Error(err), zfield.Eid(en.ID))
+		return nil, nil, errors.Wrap(err, "get entity at")
+	}
+
+	// entities of other owners not found.
+	if owner := tdtl.New([]byte(snapshot.State)).Get(fieldOwner).String(); owner != en.Owner {
+		log.L().Warn("get entity at, owner mismatched", zfield.Eid(en.ID), zfield.Owner(en.Owner))
+		return nil, nil, errors.Wrap(xerrors.ErrEntityNotFound, "get entity at")
+	}
+
+	histories, err := m.entityRepo.ListHistory(ctx, &dao.ListHistoryReq{
+		EntityID:     en.ID,
+		StartVersion: snapshot.Version + 1,
+		EndVersion:   version,
+		EndTime:      timestamp,
+	})
+	if nil != err {
+		log.L().Error("get entity at, list history", zap.Error(err), zfield.Eid(en.ID))
+		return nil, nil, errors.Wrap(err, "get entity at")
+	}
+
+	raw, err := rebuildEntity(snapshot, histories)
+	if nil != err {
+		log.L().Error("get entity at, rebuild entity", zap.Error(err),
+			zfield.Eid(en.ID), zfield.Version(snapshot.Version))
+		return nil, nil, errors.Wrap(err, "get entity at")
+	}
+
+	var baseRet BaseRet
+	if err = json.Unmarshal(raw, &baseRet); nil != err {
+		log.L().Error("get entity at, decode entity", zap.Error(err), zfield.Eid(en.ID))
+		return nil, nil, errors.Wrap(err, "get entity at, decode entity")
+	}
+
+	return &baseRet, raw, nil
+}
+
+// rebuildEntity apply changes of histories to snapshot in version order, not found if deleted.
+func rebuildEntity(snapshot *dao.EntitySnapshot, histories []dao.History) ([]byte, error) {
+	cc := tdtl.New([]byte(snapshot.State))
+	for _, h := range histories {
+		if h.Deleted {
+			return nil, errors.Wrapf(xerrors.ErrEntityNotFound, "deleted at version %d", h.Version)
+		}
+		for _, patch := range h.Patches {
+			switch xjson.NewPatchOp(patch.Op) {
+			case xjson.OpAdd:
+				cc.Append(patch.Path, tdtl.New([]byte(patch.Value)))
+			case xjson.OpRemove:
+				cc.Del(patch.Path)
+			case xjson.OpReplace:
+				cc.Set(patch.Path, tdtl.New([]byte(patch.Value)))
+			default:
+				return nil, errors.Wrapf(xerrors.ErrJSONPatchReservedOp, "history %d, operator %s", h.Version, patch.Op)
+			}
+		}
+
+		cc.Set(fieldVersion, tdtl.NewInt64(h.Version))
+		cc.Set(fieldLastTime, tdtl.NewInt64(h.Timestamp))
+		if nil != cc.Error() {
+			return nil, errors.Wrapf(cc.Error(), "history %d", h.Version)
+		}
+	}
+
+	return cc.Raw(), errors.Wrap(cc.Error(), "rebuild entity")
+}
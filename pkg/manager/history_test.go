@@ -0,0 +1,60 @@
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/core/pkg/runtime/mock"
+)
+
+func Test_rebuildEntity(t *testing.T) {
+	snapshot := &dao.EntitySnapshot{EntityID: "device123", Version: 1, Timestamp: 1000,
+		State: []byte(`{"id":"device123","version":1,"last_time":1000,"properties":{"temp":20,"hum":40}}`)}
+	histories := []dao.History{{
+		Version: 3, Timestamp: 3000, Patches: []dao.HistoryPatch{
+			{Op: "replace", Path: "properties.temp", Value: []byte(`25`)},
+			{Op: "replace", Path: "properties.metrics.cpu", Value: []byte(`0.5`)}},
+	}, {
+		Version: 5, Timestamp: 5000, Patches: []dao.HistoryPatch{
+			{Op: "remove", Path: "properties.hum"},
+			{Op: "add", Path: "properties.alarms", Value: []byte(`"high"`)}},
+	}}
+
+	raw, err := rebuildEntity(snapshot, histories[:1])
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"id":"device123","version":3,"last_time":3000,
+		"properties":{"temp":25,"hum":40,"metrics":{"cpu":0.5}}}`, string(raw))
+
+	raw, err = rebuildEntity(snapshot, histories)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"id":"device123","version":5,"last_time":5000,
+		"properties":{"temp":25,"metrics":{"cpu":0.5},"alarms":["high"]}}`, string(raw))
+
+	_, err = rebuildEntity(snapshot, []dao.History{{Version: 2,
+		Patches: []dao.HistoryPatch{{Op: "merge", Path: "properties"}}}})
+	assert.NotNil(t, err)
+
+	// entity deleted.
+	_, err = rebuildEntity(snapshot, append(histories, dao.History{Version: 6, Timestamp: 6000, Deleted: true}))
+	assert.ErrorIs(t, err, xerrors.ErrEntityNotFound)
+}
+
+func TestEntity_GetEntityAt(t *testing.T) {
+	repo := mock.NewRepo()
+	repo.EntitySnapshots = []dao.EntitySnapshot{{EntityID: "device123", Version: 1, Timestamp: 1000,
+		State: []byte(`{"id":"device123","owner":"admin","version":1,"last_time":1000,"properties":{"temp":20}}`)}}
+	repo.Histories = []dao.History{{EntityID: "device123", Version: 2, Timestamp: 2000,
+		Patches: []dao.HistoryPatch{{Op: "replace", Path: "properties.temp", Value: []byte(`25`)}}}}
+	m := &apiManager{entityRepo: repo}
+
+	ret, _, err := m.GetEntityAt(context.Background(), &Base{ID: "device123", Owner: "admin"}, 2, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), ret.Version)
+
+	// entities of other owners invisible.
+	_, _, err = m.GetEntityAt(context.Background(), &Base{ID: "device123", Owner: "other"}, 2, 0)
+	assert.ErrorIs(t, err, xerrors.ErrEntityNotFound)
+}
@@ -5,11 +5,15 @@ import (
 	"sync"
 	"time"
 
+	"github.com/pkg/errors"
 	zfield "github.com/tkeel-io/core/pkg/logger"
 	"github.com/tkeel-io/core/pkg/types"
 	"github.com/tkeel-io/kit/log"
+	"go.uber.org/zap"
 )
 
+const drainInterval = 100 * time.Millisecond
+
 type holder struct {
 	timeout time.Duration
 	holdeds map[string]chan Response
@@ -31,7 +35,28 @@ func New(ctx context.Context, timeout time.Duration) Holder {
 }
 
 func (h *holder) Cancel() {
+	h.cancel()
+}
+
+func (h *holder) Drain(ctx context.Context) error {
+	ticker := time.NewTicker(drainInterval)
+	defer ticker.Stop()
+	for h.holding() > 0 {
+		select {
+		case <-ctx.Done():
+			log.L().Warn("drain holder, cancel holding requests", zap.Int("holding", h.holding()))
+			h.Cancel()
+			return errors.Wrap(ctx.Err(), "drain holder")
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
 
+func (h *holder) holding() int {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+	return len(h.holdeds)
 }
 
 func (h *holder) Wait(ctx context.Context, id string) *Waiter {
@@ -0,0 +1,31 @@
+package holder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core/pkg/types"
+)
+
+func TestHolder_Drain(t *testing.T) {
+	h := New(context.Background(), time.Minute)
+	waiter := h.Wait(context.Background(), "req-1")
+	go h.OnRespond(&Response{ID: "req-1", Status: types.StatusOK})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.Nil(t, h.Drain(ctx))
+	assert.Equal(t, types.StatusOK, waiter.Wait().Status)
+}
+
+func TestHolder_DrainCanceled(t *testing.T) {
+	h := New(context.Background(), time.Minute)
+	waiter := h.Wait(context.Background(), "req-1")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	assert.Error(t, h.Drain(ctx))
+	assert.Equal(t, types.StatusCanceled, waiter.Wait().Status)
+}
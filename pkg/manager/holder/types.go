@@ -7,7 +7,10 @@ import (
 )
 
 type Holder interface {
+	// Cancel respond holding requests canceled.
 	Cancel()
+	// Drain wait holding requests responded, the rest canceled if ctx done.
+	Drain(ctx context.Context) error
 	Wait(ctx context.Context, id string) *Waiter
 	OnRespond(*Response)
 }
@@ -31,6 +31,7 @@ import (
 	xerrors "github.com/tkeel-io/core/pkg/errors"
 	zfield "github.com/tkeel-io/core/pkg/logger"
 	"github.com/tkeel-io/core/pkg/manager/holder"
+	"github.com/tkeel-io/core/pkg/mapper"
 	"github.com/tkeel-io/core/pkg/repository"
 	"github.com/tkeel-io/core/pkg/repository/dao"
 	"github.com/tkeel-io/core/pkg/types"
@@ -77,6 +78,12 @@ func (m *apiManager) OnRespond(ctx context.Context, resp *holder.Response) {
 	m.holder.OnRespond(resp)
 }
 
+func (m *apiManager) Shutdown(ctx context.Context) error {
+	log.L().Info("shutdown api manager, drain holding requests")
+	defer m.cancel()
+	return errors.Wrap(m.holder.Drain(ctx), "shutdown api manager")
+}
+
 // ------------------------------------APIs-----------------------------.
 
 func (m *apiManager) checkParams(base *Base) error {
@@ -92,10 +99,7 @@ func (m *apiManager) callbackAddr() string {
 
 // CreateEntity create a entity.
 func (m *apiManager) CreateEntity(ctx context.Context, en *Base) (*BaseRet, error) {
-	var (
-		err   error
-		bytes []byte
-	)
+	var err error
 
 	m.checkParams(en)
 	reqID := util.IG().ReqID()
@@ -103,7 +107,8 @@ func (m *apiManager) CreateEntity(ctx context.Context, en *Base) (*BaseRet, erro
 	log.L().Info("entity.CreateEntity", zfield.Eid(en.ID), zfield.Type(en.Type),
 		zfield.ReqID(reqID), zfield.Owner(en.Owner), zfield.Source(en.Source), zfield.Base(en.JSON()))
 
-	if bytes, err = en.EncodeJSON(); nil != err {
+	var ev *v1.ProtoEvent
+	if ev, err = m.createEvent(reqID, en); nil != err {
 		log.L().Error("create entity", zfield.Eid(en.ID), zfield.Type(en.Type),
 			zfield.ReqID(reqID), zfield.Owner(en.Owner), zfield.Source(en.Source), zfield.Base(en.JSON()))
 		return nil, errors.Wrap(err, "create entity")
@@ -113,20 +118,7 @@ func (m *apiManager) CreateEntity(ctx context.Context, en *Base) (*BaseRet, erro
 	respWaiter := m.holder.Wait(ctx, reqID)
 
 	// dispatch event.
-	if err = m.dispatcher.Dispatch(ctx, &v1.ProtoEvent{
-		Id:        util.IG().EvID(),
-		Timestamp: time.Now().UnixNano(),
-		Callback:  m.callbackAddr(),
-		Metadata: map[string]string{
-			v1.MetaType:      sysET,
-			v1.MetaRequestID: reqID,
-			v1.MetaEntityID:  en.ID},
-		Data: &v1.ProtoEvent_SystemData{
-			SystemData: &v1.SystemData{
-				Operator: string(v1.OpCreate),
-				Data:     bytes,
-			}},
-	}); nil != err {
+	if err = m.dispatcher.Dispatch(ctx, ev); nil != err {
 		respWaiter.Cancel()
 		log.L().Error("create entity, dispatch event",
 			zap.Error(err), zfield.Eid(en.ID), zfield.ReqID(reqID))
@@ -169,26 +161,8 @@ func (m *apiManager) PatchEntity(ctx context.Context, en *Base, pds []*v1.PatchD
 	// hold request.
 	respWaiter := m.holder.Wait(ctx, reqID)
 
-	// setup metadata.
-	metadata := Metadata{
-		v1.MetaType:      enET,
-		v1.MetaEntityID:  en.ID,
-		v1.MetaRequestID: reqID}
-	// use patch options.
-	for _, option := range opts {
-		option(metadata)
-	}
-
 	// dispatch event.
-	if err = m.dispatcher.Dispatch(ctx,
-		&v1.ProtoEvent{
-			Id:        util.IG().EvID(),
-			Metadata:  metadata,
-			Timestamp: time.Now().UnixNano(),
-			Callback:  m.callbackAddr(),
-			Data: &v1.ProtoEvent_Patches{
-				Patches: &v1.PatchDatas{Patches: pds}},
-		}); nil != err {
+	if err = m.dispatcher.Dispatch(ctx, m.patchEvent(reqID, en, pds, opts...)); nil != err {
 		respWaiter.Cancel()
 		log.L().Error("patch entity, dispatch event",
 			zap.Error(err), zfield.Eid(en.ID), zfield.ReqID(reqID))
@@ -281,7 +255,7 @@ func (m *apiManager) GetEntity(ctx context.Context, en *Base) (*BaseRet, error)
 }
 
 // DeleteEntity delete an entity from manager.
-func (m *apiManager) DeleteEntity(ctx context.Context, en *Base) error {
+func (m *apiManager) DeleteEntity(ctx context.Context, en *Base, opts ...Option) error {
 	var err error
 	reqID := util.IG().ReqID()
 	elapsedTime := util.NewElapsed()
@@ -292,18 +266,7 @@ func (m *apiManager) DeleteEntity(ctx context.Context, en *Base) error {
 	respWaiter := m.holder.Wait(ctx, reqID)
 
 	// dispatch event.
-	if err = m.dispatcher.Dispatch(ctx, &v1.ProtoEvent{
-		Id:        util.IG().EvID(),
-		Timestamp: time.Now().UnixNano(),
-		Callback:  m.callbackAddr(),
-		Metadata: map[string]string{
-			v1.MetaType:      sysET,
-			v1.MetaRequestID: reqID,
-			v1.MetaEntityID:  en.ID},
-		Data: &v1.ProtoEvent_SystemData{
-			SystemData: &v1.SystemData{
-				Operator: string(v1.OpDelete)},
-		}}); nil != err {
+	if err = m.dispatcher.Dispatch(ctx, m.deleteEvent(reqID, en, opts...)); nil != err {
 		respWaiter.Cancel()
 		log.L().Error("delete entity, dispatch event",
 			zap.Error(err), zfield.Eid(en.ID), zfield.ReqID(reqID))
@@ -327,6 +290,78 @@ func (m *apiManager) DeleteEntity(ctx context.Context, en *Base) error {
 	return nil
 }
 
+// createEvent returns event creating entity, responded to request reqID.
+func (m *apiManager) createEvent(reqID string, en *Base) (*v1.ProtoEvent, error) {
+	bytes, err := en.EncodeJSON()
+	if nil != err {
+		return nil, errors.Wrap(err, "encode entity")
+	}
+
+	return &v1.ProtoEvent{
+		Id:        util.IG().EvID(),
+		Timestamp: time.Now().UnixNano(),
+		Callback:  m.callbackAddr(),
+		Metadata: map[string]string{
+			v1.MetaType:      sysET,
+			v1.MetaRequestID: reqID,
+			v1.MetaEntityID:  en.ID},
+		Data: &v1.ProtoEvent_SystemData{
+			SystemData: &v1.SystemData{
+				Operator: string(v1.OpCreate),
+				Data:     bytes,
+			}},
+	}, nil
+}
+
+// patchEvent returns event patching entity, responded to request reqID.
+func (m *apiManager) patchEvent(reqID string, en *Base, pds []*v1.PatchData, opts ...Option) *v1.ProtoEvent {
+	// setup metadata.
+	metadata := Metadata{
+		v1.MetaType:      enET,
+		v1.MetaEntityID:  en.ID,
+		v1.MetaRequestID: reqID}
+	// owner of request, recorded in entity histories.
+	if en.Owner != "" {
+		metadata[v1.MetaOwner] = en.Owner
+	}
+	// use patch options.
+	for _, option := range opts {
+		option(metadata)
+	}
+
+	return &v1.ProtoEvent{
+		Id:        util.IG().EvID(),
+		Metadata:  metadata,
+		Timestamp: time.Now().UnixNano(),
+		Callback:  m.callbackAddr(),
+		Data: &v1.ProtoEvent_Patches{
+			Patches: &v1.PatchDatas{Patches: pds}},
+	}
+}
+
+// deleteEvent returns event deleting entity, responded to request reqID.
+func (m *apiManager) deleteEvent(reqID string, en *Base, opts ...Option) *v1.ProtoEvent {
+	// setup metadata.
+	metadata := Metadata{
+		v1.MetaType:      sysET,
+		v1.MetaRequestID: reqID,
+		v1.MetaEntityID:  en.ID}
+	// use delete options.
+	for _, option := range opts {
+		option(metadata)
+	}
+
+	return &v1.ProtoEvent{
+		Id:        util.IG().EvID(),
+		Timestamp: time.Now().UnixNano(),
+		Callback:  m.callbackAddr(),
+		Metadata:  metadata,
+		Data: &v1.ProtoEvent_SystemData{
+			SystemData: &v1.SystemData{
+				Operator: string(v1.OpDelete)},
+		}}
+}
+
 // AppendMapper append a mapper into entity.
 func (m *apiManager) AppendMapper(ctx context.Context, mp *dao.Mapper) error {
 	log.L().Info("entity.AppendMapper",
@@ -361,6 +396,12 @@ func (m *apiManager) AppendMapper(ctx context.Context, mp *dao.Mapper) error {
 		}
 	}
 
+	// reject cyclic mapper graph.
+	if err = m.checkMapperCycle(ctx, mp); nil != err {
+		log.L().Error("append mapper", zap.Error(err), zfield.ID(mp.ID), zfield.Eid(mp.EntityID))
+		return errors.Wrap(err, "append mapper")
+	}
+
 	if err = m.entityRepo.PutMapper(ctx, mp); nil != err {
 		log.L().Error("append mapper", zap.Error(err), zfield.ID(mp.ID), zfield.Eid(mp.EntityID))
 		return errors.Wrap(err, "append mapper")
@@ -369,6 +410,86 @@ func (m *apiManager) AppendMapper(ctx context.Context, mp *dao.Mapper) error {
 	return nil
 }
 
+// checkMapperCycle walks mappers of all owners, entities mapped across owners,
+// cycles missed by failed range still stopped by the max hops of event.
+func (m *apiManager) checkMapperCycle(ctx context.Context, mp *dao.Mapper) error {
+	var mappers []dao.Mapper
+	m.entityRepo.RangeMapper(ctx, m.entityRepo.GetLastRevision(ctx),
+		func(items []dao.Mapper) { mappers = append(mappers, items...) })
+
+	cycle, err := findMapperCycle(mappers, mp)
+	if nil != err {
+		return errors.Wrap(err, "check mapper cycle")
+	} else if len(cycle) > 0 {
+		return errors.Wrapf(xerrors.ErrMapperCycle, "cycle path %s", strings.Join(cycle, " -> "))
+	}
+	return nil
+}
+
+// findMapperCycle returns entity path of the cycle which mp introduced into mappers.
+func findMapperCycle(mappers []dao.Mapper, mp *dao.Mapper) ([]string, error) {
+	// source entity -> target entities.
+	graph := make(map[string][]string)
+	addEdges := func(m *dao.Mapper) error {
+		tdtlIns, err := tdtl.NewTDTL(m.TQL, nil)
+		if nil != err {
+			return errors.Wrap(err, "parse TQL")
+		}
+
+		target := tdtlIns.Target()
+		for source := range tdtlIns.Entities() {
+			// self mapping computed within the entity.
+			if source != target {
+				graph[source] = append(graph[source], target)
+			}
+		}
+		return nil
+	}
+
+	for index := range mappers {
+		// mapper will be replaced.
+		if mappers[index].ID == mp.ID && mappers[index].EntityID == mp.EntityID &&
+			mappers[index].Owner == mp.Owner {
+			continue
+		} else if err := addEdges(&mappers[index]); nil != err {
+			log.L().Warn("check mapper cycle", zap.Error(err),
+				zfield.ID(mappers[index].ID), zfield.Eid(mappers[index].EntityID))
+		}
+	}
+
+	if err := addEdges(mp); nil != err {
+		return nil, err
+	}
+
+	for source := range graph {
+		sort.Strings(graph[source])
+	}
+
+	// search path from target back to itself.
+	target := mp.EntityID
+	if tdtlIns, err := tdtl.NewTDTL(mp.TQL, nil); nil == err {
+		target = tdtlIns.Target()
+	}
+
+	visited := make(map[string]bool)
+	var walk func(node string, path []string) []string
+	walk = func(node string, path []string) []string {
+		for _, next := range graph[node] {
+			if next == target {
+				return append(path, next)
+			} else if !visited[next] {
+				visited[next] = true
+				if cycle := walk(next, append(path, next)); nil != cycle {
+					return cycle
+				}
+			}
+		}
+		return nil
+	}
+
+	return walk(target, []string{target}), nil
+}
+
 // DeleteMapper delete mapper from entity.
 func (m *apiManager) RemoveMapper(ctx context.Context, mp *dao.Mapper) error {
 	log.L().Info("entity.RemoveMapper",
@@ -429,12 +550,24 @@ func (m *apiManager) addMapper(ctx context.Context, base *BaseRet) error {
 	}
 
 	for _, mp := range mappers {
+		windows := make([]*v1.MapperWindow, len(mp.Windows))
+		for index, w := range mp.Windows {
+			windows[index] = &v1.MapperWindow{
+				Input:     w.Input,
+				Type:      w.Type,
+				Size:      w.Size,
+				Aggregate: w.Aggregate,
+				Emit:      w.Emit,
+			}
+		}
+
 		base.Mappers = append(base.Mappers,
 			&v1.Mapper{
 				Id:          mp.ID,
 				Tql:         mp.TQL,
 				Name:        mp.Name,
 				Description: mp.Description,
+				Windows:     windows,
 			})
 	}
 
@@ -493,5 +626,23 @@ func checkMapper(m *dao.Mapper) error {
 		return errors.Wrap(xerrors.ErrInternal, "parse TQL")
 	}
 
+	// windows of inputs watched by TQL.
+	for index := range m.Windows {
+		if key, has := propKeys[m.Windows[index].Input]; has {
+			m.Windows[index].Input = key
+		}
+		if err = mapper.CheckWindow(&m.Windows[index]); nil != err {
+			log.L().Error("check mapper", zap.Error(err), zfield.TQL(m.TQL))
+			return errors.Wrap(xerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
+	if len(m.Windows) > 0 {
+		if _, err = mapper.NewMapper(*m, 0); nil != err {
+			log.L().Error("check mapper", zap.Error(err), zfield.TQL(m.TQL))
+			return errors.Wrap(xerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
 	return nil
 }
@@ -51,3 +51,57 @@ func Test_checkTQL(t *testing.T) {
 		})
 	}
 }
+
+func Test_findMapperCycle(t *testing.T) {
+	mappers := []dao.Mapper{
+		{ID: "m1", EntityID: "device123", TQL: "insert into device123 select device234.temp as temp"},
+		{ID: "m2", EntityID: "device234", TQL: "insert into device234 select device345.temp as temp"},
+		{ID: "m3", EntityID: "device345", TQL: "insert into device345 select device345.temp as temp0"},
+	}
+
+	cycle, err := findMapperCycle(mappers, &dao.Mapper{
+		ID: "m4", EntityID: "device345", TQL: "insert into device345 select device123.temp as temp"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"device345", "device234", "device123", "device345"}, cycle)
+
+	// self mapping and acyclic graph.
+	cycle, err = findMapperCycle(mappers, &dao.Mapper{
+		ID: "m4", EntityID: "device456", TQL: "insert into device456 select device123.temp as temp"})
+	assert.Nil(t, err)
+	assert.Nil(t, cycle)
+
+	// replace mapper which introduced the cycle.
+	mappers = append(mappers, dao.Mapper{
+		ID: "m4", EntityID: "device345", TQL: "insert into device345 select device123.temp as temp"})
+	cycle, err = findMapperCycle(mappers, &dao.Mapper{
+		ID: "m4", EntityID: "device345", TQL: "insert into device345 select device456.temp as temp"})
+	assert.Nil(t, err)
+	assert.Nil(t, cycle)
+
+	// cycle across owners.
+	mappers = []dao.Mapper{
+		{ID: "m1", Owner: "admin", EntityID: "device123", TQL: "insert into device123 select device234.temp as temp"},
+		{ID: "m1", Owner: "user", EntityID: "device234", TQL: "insert into device234 select device345.temp as temp"},
+	}
+	cycle, err = findMapperCycle(mappers, &dao.Mapper{
+		ID: "m1", Owner: "admin", EntityID: "device345", TQL: "insert into device345 select device123.temp as temp"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"device345", "device234", "device123", "device345"}, cycle)
+}
+
+func Test_checkMapperWindows(t *testing.T) {
+	mp := &dao.Mapper{
+		TQL:     "insert into device123 select device234.temp as temp",
+		Windows: []dao.Window{{Input: "device234.temp", Type: "tumbling", Size: 60, Aggregate: "avg"}},
+	}
+	assert.Nil(t, checkMapper(mp))
+	assert.Equal(t, "device234.properties.temp", mp.Windows[0].Input)
+	assert.Equal(t, "update", mp.Windows[0].Emit)
+
+	// window input not watched by TQL.
+	mp = &dao.Mapper{
+		TQL:     "insert into device123 select device234.temp as temp",
+		Windows: []dao.Window{{Input: "device234.cpu", Type: "tumbling", Size: 60, Aggregate: "avg"}},
+	}
+	assert.NotNil(t, checkMapper(mp))
+}
@@ -0,0 +1,189 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/core/pkg/util"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/zap"
+)
+
+const (
+	// attempts of committing participants.
+	txCommitAttempts = 3
+	txCommitBackoff  = 500 * time.Millisecond
+)
+
+// TxItem patches of entity in transaction.
+type TxItem struct {
+	Entity          *Base
+	Patches         []*v1.PatchData
+	ExpectedVersion int64
+}
+
+// TxResult entity committed by transaction.
+type TxResult struct {
+	Ret *BaseRet
+	Raw []byte
+	Err error
+}
+
+// CommitTransaction apply patches of entities all-or-nothing by two-phase commit.
+func (m *apiManager) CommitTransaction(ctx context.Context, items []*TxItem, timeout time.Duration) (string, []*TxResult, error) {
+	txID := util.IG().TxID()
+	entities := make([]string, len(items))
+	for index, item := range items {
+		entities[index] = item.Entity.ID
+	}
+
+	elapsedTime := util.NewElapsed()
+	log.L().Info("entity.CommitTransaction", zap.String("tx", txID), zap.Strings("entities", entities))
+
+	// phase 1: prepare, entities locked if succeed.
+	pctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var cause error
+	for index, res := range m.txPhase(pctx, txID, v1.TxPrepare, items) {
+		if nil != res.Err {
+			cause = errors.Wrapf(res.Err, "transaction aborted, prepare entity %s", entities[index])
+			break
+		}
+	}
+
+	// record the decision, participants resolve by it if coordinator gone.
+	status := dao.TxCommitted
+	if nil != cause {
+		status = dao.TxAborted
+	}
+
+	decided, err := m.entityRepo.DecideTransaction(m.ctx, &dao.Transaction{
+		ID:        txID,
+		Status:    status,
+		Entities:  entities,
+		Timestamp: time.Now().UnixNano() / 1e6,
+	})
+	if nil != err && nil == cause {
+		cause = errors.Wrap(err, "transaction aborted, decide")
+	} else if nil == err && dao.TxCommitted != decided.Status && nil == cause {
+		// participant locked too long, aborted by itself.
+		cause = errors.Wrap(xerrors.ErrTransactionAborted, "participant timeout")
+	}
+
+	if nil != cause {
+		for index, res := range m.txPhase(m.ctx, txID, v1.TxAbort, items) {
+			if nil != res.Err {
+				log.L().Warn("abort transaction", zap.String("tx", txID),
+					zfield.Eid(entities[index]), zap.Error(res.Err))
+			}
+		}
+
+		log.L().Error("commit transaction", zap.String("tx", txID), zap.Error(cause))
+		return txID, nil, cause
+	}
+
+	// phase 2: commit, the decision is final even if client gone.
+	results, err := m.commitTx(txID, items)
+	if nil != err {
+		log.L().Error("commit transaction", zap.String("tx", txID), zap.Error(err))
+		return txID, results, errors.Wrap(err, "commit transaction")
+	}
+
+	log.L().Info("transaction committed", zap.String("tx", txID),
+		zfield.Elapsed(elapsedTime.Elapsed()))
+	return txID, results, nil
+}
+
+// commitTx dispatch commit to participants until all applied, participants
+// not committed resolve by the decision once their locks timeout.
+func (m *apiManager) commitTx(txID string, items []*TxItem) ([]*TxResult, error) {
+	results := make([]*TxResult, len(items))
+	pending := make([]int, len(items))
+	for index := range items {
+		pending[index] = index
+	}
+
+	for attempt := 0; ; attempt++ {
+		commits := make([]*TxItem, len(pending))
+		for index, itemIndex := range pending {
+			commits[index] = items[itemIndex]
+		}
+
+		var failed []int
+		for index, res := range m.txPhase(m.ctx, txID, v1.TxCommit, commits) {
+			itemIndex := pending[index]
+			if nil != res.Err && attempt > 0 && errors.Is(res.Err, xerrors.ErrTransactionNotFound) {
+				// applied by the last attempt, response lost.
+				res = m.committedResult(items[itemIndex].Entity)
+			}
+
+			results[itemIndex] = res
+			if nil != res.Err {
+				log.L().Warn("commit transaction, retry", zap.String("tx", txID),
+					zfield.Eid(items[itemIndex].Entity.ID), zap.Int("attempt", attempt), zap.Error(res.Err))
+				failed = append(failed, itemIndex)
+			}
+		}
+
+		if pending = failed; len(pending) == 0 {
+			return results, nil
+		} else if attempt+1 >= txCommitAttempts {
+			break
+		}
+
+		select {
+		case <-m.ctx.Done():
+			return results, errors.Wrap(m.ctx.Err(), "commit transaction")
+		case <-time.After(txCommitBackoff * time.Duration(attempt+1)):
+		}
+	}
+
+	entities := make([]string, len(pending))
+	for index, itemIndex := range pending {
+		entities[index] = items[itemIndex].Entity.ID
+	}
+	return results, errors.Wrapf(xerrors.ErrTransactionIncomplete, "entities %v not committed", entities)
+}
+
+// committedResult returns the entity committed by transaction.
+func (m *apiManager) committedResult(en *Base) *TxResult {
+	ret, err := m.GetEntity(m.ctx, en)
+	if nil != err {
+		return &TxResult{Err: err}
+	}
+
+	raw, err := json.Marshal(ret)
+	return &TxResult{Ret: ret, Raw: raw, Err: errors.Wrap(err, "encode entity")}
+}
+
+// txPhase dispatch phase of transaction to entities concurrently.
+func (m *apiManager) txPhase(ctx context.Context, txID string, phase v1.TxPhase, items []*TxItem) []*TxResult {
+	var wg sync.WaitGroup
+	results := make([]*TxResult, len(items))
+	for index := range items {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			var pds []*v1.PatchData
+			opts := []Option{NewTxOption(txID, phase)}
+			if v1.TxPrepare == phase {
+				pds = items[index].Patches
+				opts = append(opts, NewExpectedVersionOption(items[index].ExpectedVersion))
+			}
+
+			ret, raw, err := m.PatchEntity(ctx, items[index].Entity, pds, opts...)
+			results[index] = &TxResult{Ret: ret, Raw: raw, Err: err}
+		}(index)
+	}
+
+	wg.Wait()
+	return results
+}
@@ -19,6 +19,8 @@ package manager
 import (
 	"context"
 	"errors"
+	"strconv"
+	"time"
 
 	v1 "github.com/tkeel-io/core/api/core/v1"
 	"github.com/tkeel-io/core/pkg/manager/holder"
@@ -43,9 +45,15 @@ type APIManager interface {
 	// UpdateEntity update entity.
 	PatchEntity(context.Context, *Base, []*v1.PatchData, ...Option) (*BaseRet, []byte, error)
 	// DeleteEntity delete entity.
-	DeleteEntity(context.Context, *Base) error
+	DeleteEntity(context.Context, *Base, ...Option) error
 	// GetProperties returns entity properties.
 	GetEntity(context.Context, *Base) (*BaseRet, error)
+	// GetEntityAt returns entity as it was at version or timestamp, latest if zero.
+	GetEntityAt(ctx context.Context, en *Base, version, timestamp int64) (*BaseRet, []byte, error)
+	// BatchEntities operate entities, one event for entities of the same runtime.
+	BatchEntities(context.Context, []*BatchItem) []*BatchResult
+	// CommitTransaction apply patches of entities all-or-nothing, returns transaction id.
+	CommitTransaction(ctx context.Context, items []*TxItem, timeout time.Duration) (string, []*TxResult, error)
 	// AppendMapper append entity mapper.
 	AppendMapper(context.Context, *dao.Mapper) error
 	// RemoveMapper remove entity mapper.
@@ -54,6 +62,8 @@ type APIManager interface {
 	GetMapper(context.Context, *dao.Mapper) (*dao.Mapper, error)
 	// ListMapper returns entity mappers.
 	ListMapper(context.Context, *Base) ([]dao.Mapper, error)
+	// Shutdown wait holding requests responded until ctx done.
+	Shutdown(context.Context) error
 }
 
 type Metadata map[string]string
@@ -65,3 +75,20 @@ func NewPathConstructorOption(pc v1.PathConstructor) Option {
 		meta[v1.MetaPathConstructor] = string(pc)
 	}
 }
+
+// NewExpectedVersionOption reject the request if entity version not matched.
+func NewExpectedVersionOption(version int64) Option {
+	return func(meta Metadata) {
+		if version > 0 {
+			meta[v1.MetaExpectedVersion] = strconv.FormatInt(version, 10)
+		}
+	}
+}
+
+// NewTxOption mark the request as phase of transaction.
+func NewTxOption(txID string, phase v1.TxPhase) Option {
+	return func(meta Metadata) {
+		meta[v1.MetaTxID] = txID
+		meta[v1.MetaTxPhase] = string(phase)
+	}
+}
@@ -33,6 +33,25 @@ func NewMapper(mp dao.Mapper, version int64) (Mapper, error) {
 	if nil != err {
 		return nil, errors.Wrap(err, "construct mapper")
 	}
+
+	inputs := make(map[string]bool)
+	for _, keys := range tqlInst.Entities() {
+		for _, key := range keys {
+			inputs[key] = true
+		}
+	}
+
+	windows := make([]dao.Window, len(mp.Windows))
+	for index := range mp.Windows {
+		windows[index] = mp.Windows[index]
+		if err = CheckWindow(&windows[index]); nil != err {
+			return nil, errors.Wrap(err, "construct mapper")
+		} else if !inputs[windows[index].Input] {
+			return nil, errors.Errorf("construct mapper, window input %s not watched", windows[index].Input)
+		}
+	}
+	mp.Windows = windows
+
 	return &mapper{
 		mapper:  mp,
 		version: version,
@@ -105,6 +124,11 @@ func (m *mapper) Copy() Mapper {
 	return mCopy
 }
 
+// Windows returns windows of inputs.
+func (m *mapper) Windows() []dao.Window {
+	return m.mapper.Windows
+}
+
 // Exec input returns output.
 func (m *mapper) Exec(values map[string]tdtl.Node) (map[string]tdtl.Node, error) {
 	res, err := m.tqlInst.Exec(values)
@@ -20,6 +20,7 @@ import (
 	"crypto/rand"
 	"fmt"
 
+	"github.com/tkeel-io/core/pkg/repository/dao"
 	"github.com/tkeel-io/tdtl"
 )
 
@@ -51,6 +52,8 @@ type Mapper interface {
 	Copy() Mapper
 	// Exec excute input returns output.
 	Exec(map[string]tdtl.Node) (map[string]tdtl.Node, error)
+	// Windows returns windows of inputs.
+	Windows() []dao.Window
 }
 
 type TentacleType = string
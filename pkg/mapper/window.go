@@ -0,0 +1,241 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mapper
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/tdtl"
+)
+
+const (
+	WindowTumbling = "tumbling"
+	WindowSliding  = "sliding"
+
+	EmitUpdate = "update"
+	EmitClose  = "close"
+
+	AggregateAvg     = "avg"
+	AggregateMax     = "max"
+	AggregateMin     = "min"
+	AggregateSum     = "sum"
+	AggregateCount   = "count"
+	AggregateChanges = "changes"
+)
+
+// CheckWindow validate window of mapper input, emit defaults to update.
+func CheckWindow(w *dao.Window) error {
+	if w.Input == "" {
+		return errors.New("window input empty")
+	} else if w.Size <= 0 {
+		return errors.Errorf("window %s, invalid size %d", w.Input, w.Size)
+	}
+
+	switch w.Aggregate {
+	case AggregateAvg, AggregateMax, AggregateMin,
+		AggregateSum, AggregateCount, AggregateChanges:
+	default:
+		return errors.Errorf("window %s, invalid aggregate %s", w.Input, w.Aggregate)
+	}
+
+	if w.Emit == "" {
+		w.Emit = EmitUpdate
+	}
+
+	switch {
+	case w.Emit != EmitUpdate && w.Emit != EmitClose:
+		return errors.Errorf("window %s, invalid emit %s", w.Input, w.Emit)
+	case w.Type == WindowTumbling:
+	case w.Type == WindowSliding:
+		// sliding window never closes.
+		if w.Emit == EmitClose {
+			return errors.Errorf("window %s, sliding window emits on update only", w.Input)
+		}
+	default:
+		return errors.Errorf("window %s, invalid type %s", w.Input, w.Type)
+	}
+	return nil
+}
+
+// max samples kept by sliding window, the oldest evicted.
+const windowSampleLimit = 10000
+
+// Sample value of input sampled at milliseconds.
+type Sample struct {
+	At    int64  `json:"at"`
+	Value []byte `json:"value"`
+}
+
+// Aggregates running aggregates of samples.
+type Aggregates struct {
+	// Count samples added.
+	Count int64 `json:"count"`
+	// Numbers numeric samples added, which sum, min and max aggregated.
+	Numbers int64   `json:"numbers"`
+	Sum     float64 `json:"sum"`
+	Min     float64 `json:"min"`
+	Max     float64 `json:"max"`
+	// Changes transitions of sample values.
+	Changes int64 `json:"changes"`
+}
+
+// add aggregate sample value, transitions counted from the last value.
+func (a *Aggregates) add(last, val []byte) {
+	a.Count++
+	if nil != last && !bytes.Equal(last, val) {
+		a.Changes++
+	}
+
+	num, err := strconv.ParseFloat(strings.Trim(string(val), `"`), 64)
+	if nil != err {
+		return
+	}
+	if a.Numbers == 0 || num < a.Min {
+		a.Min = num
+	}
+	if a.Numbers == 0 || num > a.Max {
+		a.Max = num
+	}
+	a.Numbers++
+	a.Sum += num
+}
+
+func (a *Aggregates) value(w dao.Window) tdtl.Node {
+	switch w.Aggregate {
+	case AggregateCount:
+		return tdtl.IntNode(a.Count)
+	case AggregateChanges:
+		return tdtl.IntNode(a.Changes)
+	}
+
+	if a.Numbers == 0 {
+		return nil
+	}
+
+	switch w.Aggregate {
+	case AggregateAvg:
+		return tdtl.FloatNode(a.Sum / float64(a.Numbers))
+	case AggregateMax:
+		return tdtl.FloatNode(a.Max)
+	case AggregateMin:
+		return tdtl.FloatNode(a.Min)
+	default:
+		return tdtl.FloatNode(a.Sum)
+	}
+}
+
+// WindowState samples of window, encoded into runtime snapshot.
+type WindowState struct {
+	// Start milliseconds of tumbling window started.
+	Start int64 `json:"start"`
+	// Samples samples within sliding window, ordered by time.
+	Samples []Sample `json:"samples"`
+	// Running aggregates of the current tumbling window.
+	Running Aggregates `json:"running"`
+	// Last value before samples of sliding window, the latest value of tumbling window,
+	// transitions counted across windows.
+	Last []byte `json:"last"`
+	// Closed aggregate of the last closed tumbling window.
+	Closed []byte `json:"closed"`
+}
+
+// Add sample value of input sampled at, returns true if tumbling window closed before.
+func (s *WindowState) Add(w dao.Window, at time.Time, val tdtl.Node) bool {
+	closed := s.Roll(w, at)
+	if w.Type == WindowTumbling {
+		if s.Start == 0 {
+			s.Start = windowStart(w, at)
+		}
+		s.Running.add(s.Last, val.Raw())
+		s.Last = val.Raw()
+		return closed
+	}
+
+	// samples delivered out of order inserted by time.
+	sample := Sample{At: at.UnixMilli(), Value: val.Raw()}
+	index := sort.Search(len(s.Samples), func(i int) bool { return s.Samples[i].At > sample.At })
+	s.Samples = append(s.Samples, Sample{})
+	copy(s.Samples[index+1:], s.Samples[index:])
+	s.Samples[index] = sample
+	if len(s.Samples) > windowSampleLimit {
+		s.Last = s.Samples[0].Value
+		s.Samples = s.Samples[1:]
+	}
+	return closed
+}
+
+// Roll evict expired samples, returns true if tumbling window closed.
+func (s *WindowState) Roll(w dao.Window, now time.Time) bool {
+	switch w.Type {
+	case WindowSliding:
+		since := now.Add(-time.Duration(w.Size) * time.Second).UnixMilli()
+		var index int
+		for index < len(s.Samples) && s.Samples[index].At <= since {
+			s.Last = s.Samples[index].Value
+			index++
+		}
+		s.Samples = s.Samples[index:]
+	case WindowTumbling:
+		if s.Start == 0 || now.UnixMilli() < s.Start+w.Size*1000 {
+			return false
+		}
+
+		s.Closed = nil
+		if val := s.Running.value(w); nil != val {
+			s.Closed = val.Raw()
+		}
+		s.Running = Aggregates{}
+		s.Start = windowStart(w, now)
+		return true
+	}
+	return false
+}
+
+// Value returns aggregate of window emitted, nil if window empty.
+func (s *WindowState) Value(w dao.Window, now time.Time) tdtl.Node {
+	if w.Emit == EmitClose {
+		if nil == s.Closed {
+			return nil
+		}
+		return tdtl.New(s.Closed)
+	}
+
+	s.Roll(w, now)
+	if w.Type == WindowTumbling {
+		return s.Running.value(w)
+	}
+
+	var aggregates Aggregates
+	last := s.Last
+	for _, sample := range s.Samples {
+		aggregates.add(last, sample.Value)
+		last = sample.Value
+	}
+	return aggregates.value(w)
+}
+
+// windowStart returns start milliseconds of tumbling window, aligned to size.
+func windowStart(w dao.Window, at time.Time) int64 {
+	size := w.Size * 1000
+	return at.UnixMilli() / size * size
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mapper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/tdtl"
+)
+
+func TestCheckWindow(t *testing.T) {
+	w := dao.Window{Input: "src.temp", Type: WindowTumbling, Size: 60, Aggregate: AggregateAvg}
+	assert.Nil(t, CheckWindow(&w))
+	assert.Equal(t, EmitUpdate, w.Emit)
+
+	windows := []dao.Window{
+		{Type: WindowTumbling, Size: 60, Aggregate: AggregateAvg},
+		{Input: "src.temp", Type: WindowTumbling, Aggregate: AggregateAvg},
+		{Input: "src.temp", Type: "hopping", Size: 60, Aggregate: AggregateAvg},
+		{Input: "src.temp", Type: WindowTumbling, Size: 60, Aggregate: "median"},
+		{Input: "src.temp", Type: WindowSliding, Size: 60, Aggregate: AggregateAvg, Emit: EmitClose},
+	}
+	for index := range windows {
+		assert.NotNil(t, CheckWindow(&windows[index]))
+	}
+}
+
+func TestWindowState_Sliding(t *testing.T) {
+	w := dao.Window{Input: "src.temp", Type: WindowSliding, Size: 10, Aggregate: AggregateAvg, Emit: EmitUpdate}
+	now := time.Unix(1000, 0)
+
+	var state WindowState
+	assert.Nil(t, state.Value(w, now))
+	state.Add(w, now, tdtl.IntNode(10))
+	state.Add(w, now.Add(5*time.Second), tdtl.IntNode(20))
+	assert.Equal(t, "15.000000", state.Value(w, now.Add(5*time.Second)).String())
+
+	// the first sample evicted.
+	assert.Equal(t, "20.000000", state.Value(w, now.Add(12*time.Second)).String())
+
+	w.Aggregate = AggregateMax
+	state.Add(w, now.Add(13*time.Second), tdtl.FloatNode(12.5))
+	assert.Equal(t, "20.000000", state.Value(w, now.Add(13*time.Second)).String())
+}
+
+func TestWindowState_Tumbling(t *testing.T) {
+	w := dao.Window{Input: "src.state", Type: WindowTumbling, Size: 60, Aggregate: AggregateChanges, Emit: EmitClose}
+	now := time.Unix(6000, 0)
+
+	var state WindowState
+	assert.False(t, state.Add(w, now, tdtl.StringNode("on")))
+	assert.False(t, state.Add(w, now.Add(10*time.Second), tdtl.StringNode("off")))
+	assert.False(t, state.Add(w, now.Add(20*time.Second), tdtl.StringNode("off")))
+	assert.False(t, state.Add(w, now.Add(30*time.Second), tdtl.StringNode("on")))
+	assert.Nil(t, state.Value(w, now.Add(30*time.Second)))
+
+	// window closed by the next sample.
+	assert.True(t, state.Add(w, now.Add(70*time.Second), tdtl.StringNode("off")))
+	assert.Equal(t, "2", state.Value(w, now.Add(70*time.Second)).String())
+
+	// transitions counted across windows, window closed by time.
+	assert.True(t, state.Roll(w, now.Add(120*time.Second)))
+	assert.Equal(t, "1", state.Value(w, now.Add(120*time.Second)).String())
+	assert.False(t, state.Roll(w, now.Add(130*time.Second)))
+
+	w.Aggregate, w.Emit = AggregateCount, EmitUpdate
+	state.Add(w, now.Add(130*time.Second), tdtl.StringNode("on"))
+	assert.Equal(t, "1", state.Value(w, now.Add(130*time.Second)).String())
+}
+
+func TestWindowState_Samples(t *testing.T) {
+	w := dao.Window{Input: "src.temp", Type: WindowSliding, Size: 60, Aggregate: AggregateSum, Emit: EmitUpdate}
+	now := time.Unix(1000, 0)
+
+	// samples delivered out of order.
+	var state WindowState
+	state.Add(w, now.Add(2*time.Second), tdtl.IntNode(2))
+	state.Add(w, now, tdtl.IntNode(1))
+	assert.Equal(t, int64(1000000), state.Samples[0].At)
+	assert.Equal(t, "3.000000", state.Value(w, now.Add(2*time.Second)).String())
+
+	// samples bounded.
+	for i := 0; i < windowSampleLimit; i++ {
+		state.Add(w, now.Add(3*time.Second), tdtl.IntNode(1))
+	}
+	assert.Len(t, state.Samples, windowSampleLimit)
+	assert.Equal(t, "2", string(state.Last))
+}
@@ -1,56 +1,146 @@
 package placement
 
 import (
+	"hash/fnv"
 	"sort"
+	"strconv"
 	"sync"
-
-	"github.com/tkeel-io/core/pkg/util"
 )
 
+// virtual nodes of queue per weight.
+const virtualNodes = 160
+
 var globalPlacement *placement
 
 type placement struct {
-	lock      sync.RWMutex
-	queues    map[string]Info
-	hashTable sort.StringSlice
+	lock   sync.RWMutex
+	queues map[string]Info
+	ring   *hashRing
 }
 
 func New() Placement {
-	return &placement{}
+	return &placement{
+		queues: make(map[string]Info),
+		ring:   newHashRing(nil),
+	}
 }
 
 func (p *placement) Append(info Info) {
 	p.lock.Lock()
 	p.queues[info.ID] = info
-	p.hashTable = append(p.hashTable, info.ID)
-	sort.Sort(p.hashTable)
-	p.hashTable = util.Unique(p.hashTable)
+	p.ring = newHashRing(p.queues)
 	p.lock.Unlock()
 }
 
 func (p *placement) Remove(info Info) {
 	p.lock.Lock()
 	delete(p.queues, info.ID)
-	index := p.hashTable.Search(info.ID)
-	if p.hashTable.Len() > index && info.ID == p.hashTable[index] {
-		p.hashTable = append(p.hashTable[:index], p.hashTable[index+1:]...)
-	}
+	p.ring = newHashRing(p.queues)
 	p.lock.Unlock()
 }
 
+// Select returns queue of key, empty Info if no queue.
 func (p *placement) Select(key string) Info {
-	hashKey := util.Hash32(key)
 	p.lock.RLock()
-	selectIndex := hashKey % uint32(p.hashTable.Len())
-	info := p.queues[p.hashTable[selectIndex]]
+	defer p.lock.RUnlock()
+	return p.queues[p.ring.get(key)]
+}
+
+func (p *placement) Infos() []Info {
+	p.lock.RLock()
+	infos := make([]Info, 0, len(p.queues))
+	for _, info := range p.queues {
+		infos = append(infos, info)
+	}
 	p.lock.RUnlock()
-	return info
+
+	sort.Slice(infos, func(i, j int) bool { return infos[i].ID < infos[j].ID })
+	return infos
+}
+
+func (p *placement) Preview(keys []string, infos []Info) []Move {
+	queues := make(map[string]Info, len(infos))
+	for _, info := range infos {
+		queues[info.ID] = info
+	}
+
+	ring := newHashRing(queues)
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	var moves []Move
+	for _, key := range keys {
+		from, to := p.ring.get(key), ring.get(key)
+		if from != to {
+			moves = append(moves, Move{Key: key, From: from, To: to})
+		}
+	}
+	return moves
 }
 
 func Initialize() {
 	globalPlacement = &placement{
-		lock:      sync.RWMutex{},
-		queues:    make(map[string]Info),
-		hashTable: sort.StringSlice{},
+		lock:   sync.RWMutex{},
+		queues: make(map[string]Info),
+		ring:   newHashRing(nil),
 	}
 }
+
+type vnode struct {
+	hash uint32
+	id   string
+}
+
+// hashRing consistent hash ring, only keys of the queue changed are moved.
+type hashRing struct {
+	nodes []vnode
+}
+
+// newHashRing build ring, same on all nodes whatever the order queues appended.
+func newHashRing(queues map[string]Info) *hashRing {
+	ring := &hashRing{}
+	for id, info := range queues {
+		weight := info.Weight
+		if weight <= 0 {
+			weight = 1
+		}
+		for i := 0; i < weight*virtualNodes; i++ {
+			ring.nodes = append(ring.nodes, vnode{hash: hash(id + "#" + strconv.Itoa(i)), id: id})
+		}
+	}
+
+	sort.Slice(ring.nodes, func(i, j int) bool {
+		if ring.nodes[i].hash == ring.nodes[j].hash {
+			return ring.nodes[i].id < ring.nodes[j].id
+		}
+		return ring.nodes[i].hash < ring.nodes[j].hash
+	})
+	return ring
+}
+
+// get returns id of the first vnode clockwise from key.
+func (r *hashRing) get(key string) string {
+	if len(r.nodes) == 0 {
+		return ""
+	}
+
+	h := hash(key)
+	index := sort.Search(len(r.nodes), func(i int) bool { return r.nodes[i].hash >= h })
+	if index == len(r.nodes) {
+		index = 0
+	}
+	return r.nodes[index].id
+}
+
+// hash fnv-1a with murmur3 finalizer, spread similar keys(device1, device2) over the ring.
+func hash(key string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(key)) //nolint
+	x := h.Sum32()
+	x ^= x >> 16
+	x *= 0x85ebca6b
+	x ^= x >> 13
+	x *= 0xc2b2ae35
+	x ^= x >> 16
+	return x
+}
@@ -0,0 +1,91 @@
+package placement
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func keys(n int) []string {
+	ks := make([]string, n)
+	for i := range ks {
+		ks[i] = fmt.Sprintf("device%d", i)
+	}
+	return ks
+}
+
+func TestPlacement_Select(t *testing.T) {
+	p := New()
+	assert.Equal(t, Info{}, p.Select("device1"))
+
+	for i := 0; i < 4; i++ {
+		p.Append(Info{ID: fmt.Sprintf("core%d", i)})
+	}
+
+	counts := map[string]int{}
+	for _, key := range keys(10000) {
+		counts[p.Select(key).ID]++
+	}
+	for id, count := range counts {
+		assert.InDelta(t, 2500, count, 500, id)
+	}
+
+	// same ring whatever the order appended.
+	q := New()
+	for i := 3; i >= 0; i-- {
+		q.Append(Info{ID: fmt.Sprintf("core%d", i)})
+	}
+	for _, key := range keys(1000) {
+		assert.Equal(t, p.Select(key).ID, q.Select(key).ID)
+	}
+}
+
+func TestPlacement_Weight(t *testing.T) {
+	p := New()
+	p.Append(Info{ID: "core0"})
+	p.Append(Info{ID: "core1", Weight: 3})
+
+	counts := map[string]int{}
+	for _, key := range keys(10000) {
+		counts[p.Select(key).ID]++
+	}
+	assert.InDelta(t, 7500, counts["core1"], 750)
+}
+
+func TestPlacement_Preview(t *testing.T) {
+	p := New()
+	for i := 0; i < 4; i++ {
+		p.Append(Info{ID: fmt.Sprintf("core%d", i)})
+	}
+
+	ks := keys(10000)
+	infos := append(p.Infos(), Info{ID: "core4"})
+	moves := p.Preview(ks, infos)
+
+	// only keys moved to the new queue.
+	assert.InDelta(t, 2000, len(moves), 400)
+	for _, mv := range moves {
+		assert.Equal(t, "core4", mv.To)
+	}
+
+	before := map[string]string{}
+	for _, key := range ks {
+		before[key] = p.Select(key).ID
+	}
+	p.Append(Info{ID: "core4"})
+	moved := 0
+	for _, key := range ks {
+		if before[key] != p.Select(key).ID {
+			moved++
+		}
+	}
+	assert.Equal(t, len(moves), moved)
+
+	// remove queue, keys of it moved only.
+	for _, mv := range p.Preview(ks, infos[1:]) {
+		assert.Equal(t, "core0", mv.From)
+	}
+	p.Remove(Info{ID: "core0"})
+	assert.NotEqual(t, "core0", p.Select("device1").ID)
+}
@@ -1,14 +1,26 @@
 package placement
 
 type Info struct {
-	ID   string
-	Flag bool
+	ID   string `json:"id"`
+	Flag bool   `json:"flag"`
+	// weight of queue on hash ring, 0 same as 1.
+	Weight int `json:"weight"`
+}
+
+// Move entity placed to another queue after topology changed.
+type Move struct {
+	Key  string `json:"key"`
+	From string `json:"from"`
+	To   string `json:"to"`
 }
 
 type Placement interface {
 	Select(string) Info
 	Append(Info)
 	Remove(Info)
+	Infos() []Info
+	// Preview returns keys which would be moved if queues changed to infos.
+	Preview(keys []string, infos []Info) []Move
 }
 
 func Global() Placement {
@@ -83,6 +83,8 @@ func (d *Dao) GetLastRevision(ctx context.Context) int64 {
 	return rev
 }
 
-func (d *Dao) Close() {
+// Close stop watchers and release etcd client.
+func (d *Dao) Close() error {
 	d.cancel()
+	return errors.Wrap(d.etcdEndpoint.Close(), "close dao")
 }
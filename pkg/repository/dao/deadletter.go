@@ -0,0 +1,126 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/kit/log"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+)
+
+const (
+	// store dead letter prefix key.
+	DeadLetterPrefix = "core/v1/deadletters"
+	// core/v1/deadletters/{runtimeID}/{id} .
+	fmtDeadLetterString = "%s/%s/%s"
+)
+
+// DeadLetter event failed processing, with failure metadata.
+type DeadLetter struct {
+	ID        string `json:"id"`
+	RuntimeID string `json:"runtime_id"`
+	Error     string `json:"error"`
+	Attempts  int64  `json:"attempts"`
+	Timestamp int64  `json:"timestamp"`
+	// Event encoded v1.ProtoEvent, empty if forwarded to sink or dropped.
+	Event []byte `json:"event"`
+	// Sink pubsub url which the event forwarded to.
+	Sink string `json:"sink,omitempty"`
+	// EventSize size of event encoded, the event dropped if larger than limit and not forwarded.
+	EventSize int `json:"event_size,omitempty"`
+	// Dropped event not kept, metadata only.
+	Dropped bool `json:"dropped,omitempty"`
+}
+
+func (dl *DeadLetter) Key() string {
+	return fmt.Sprintf(fmtDeadLetterString, DeadLetterPrefix, dl.RuntimeID, dl.ID)
+}
+
+type ListDeadLetterReq struct {
+	RuntimeID string
+	Limit     int64
+	// After list dead letters after {runtimeID}/{id}, exclusive.
+	After string
+}
+
+// PutDeadLetter persist dead letter, deleted once lease expired, never expires if lease zero.
+func (d *Dao) PutDeadLetter(ctx context.Context, dl *DeadLetter, lease int64) error {
+	var err error
+	var bytes []byte
+	if bytes, err = json.Marshal(dl); nil == err {
+		var opts []clientv3.OpOption
+		if lease > 0 {
+			opts = append(opts, clientv3.WithLease(clientv3.LeaseID(lease)))
+		}
+		_, err = d.etcdEndpoint.Put(ctx, dl.Key(), string(bytes), opts...)
+	}
+	return errors.Wrap(err, "put dead letter")
+}
+
+// CountDeadLetter returns count of dead letters of runtime.
+func (d *Dao) CountDeadLetter(ctx context.Context, runtimeID string) (int64, error) {
+	prefix := fmt.Sprintf("%s/%s/", DeadLetterPrefix, runtimeID)
+	res, err := d.etcdEndpoint.Get(ctx, prefix, clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if nil != err {
+		return 0, errors.Wrap(err, "count dead letter")
+	}
+	return res.Count, nil
+}
+
+func (d *Dao) GetDeadLetter(ctx context.Context, dl *DeadLetter) (*DeadLetter, error) {
+	res, err := d.etcdEndpoint.Get(ctx, dl.Key())
+	if nil == err {
+		if len(res.Kvs) == 0 {
+			return dl, xerrors.ErrDeadLetterNotFound
+		}
+		err = json.Unmarshal(res.Kvs[0].Value, dl)
+	}
+	return dl, errors.Wrap(err, "get dead letter")
+}
+
+func (d *Dao) DelDeadLetter(ctx context.Context, dl *DeadLetter) error {
+	_, err := d.etcdEndpoint.Delete(ctx, dl.Key())
+	return errors.Wrap(err, "delete dead letter")
+}
+
+func (d *Dao) ListDeadLetter(ctx context.Context, req *ListDeadLetterReq) ([]DeadLetter, error) {
+	prefix := DeadLetterPrefix + "/"
+	if req.RuntimeID != "" {
+		prefix += req.RuntimeID + "/"
+	}
+
+	// keys ordered, page continued after the last key.
+	start := prefix
+	if after := DeadLetterPrefix + "/" + req.After + "\x00"; req.After != "" && after > start {
+		start = after
+	}
+
+	opts := []clientv3.OpOption{clientv3.WithRange(clientv3.GetPrefixRangeEnd(prefix))}
+	if req.Limit > 0 {
+		opts = append(opts, clientv3.WithLimit(req.Limit))
+	}
+
+	resp, err := d.etcdEndpoint.Get(ctx, start, opts...)
+	if nil != err {
+		log.L().Error("list dead letter", zap.Error(err), zfield.Prefix(prefix))
+		return nil, errors.Wrap(err, "list dead letter")
+	}
+
+	letters := make([]DeadLetter, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var dl DeadLetter
+		if err = json.Unmarshal(kv.Value, &dl); nil != err {
+			log.L().Error("unmarshal dead letter", zap.Error(err),
+				zfield.Key(string(kv.Key)), zfield.Value(string(kv.Value)))
+			continue
+		}
+		letters = append(letters, dl)
+	}
+
+	return letters, nil
+}
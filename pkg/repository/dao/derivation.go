@@ -0,0 +1,67 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/kit/log"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+)
+
+const (
+	// store derivation prefix key.
+	DerivationPrefix = "core/v1/derivations"
+	// core/v1/derivations/{templateID}/{entityID} .
+	fmtDerivationString = "%s/%s/%s"
+)
+
+// Derivation reverse index from template to entity which inherits it.
+type Derivation struct {
+	TemplateID string `json:"template_id"`
+	EntityID   string `json:"entity_id"`
+}
+
+func (d *Derivation) Key() string {
+	return fmt.Sprintf(fmtDerivationString, DerivationPrefix, d.TemplateID, d.EntityID)
+}
+
+func (d *Dao) PutDerivation(ctx context.Context, dv *Derivation) error {
+	var err error
+	var bytes []byte
+	if bytes, err = json.Marshal(dv); nil == err {
+		_, err = d.commit(ctx, nil, []clientv3.Op{clientv3.OpPut(dv.Key(), string(bytes))}, nil)
+	}
+	return errors.Wrap(err, "put derivation")
+}
+
+func (d *Dao) DelDerivation(ctx context.Context, dv *Derivation) error {
+	_, err := d.commit(ctx, nil, []clientv3.Op{clientv3.OpDelete(dv.Key())}, nil)
+	return errors.Wrap(err, "delete derivation")
+}
+
+// ListDerivation returns entities which inherit the template directly.
+func (d *Dao) ListDerivation(ctx context.Context, templateID string) ([]Derivation, error) {
+	prefix := fmt.Sprintf("%s/%s/", DerivationPrefix, templateID)
+	resp, err := d.etcdEndpoint.Get(ctx, prefix, clientv3.WithPrefix())
+	if nil != err {
+		log.L().Error("list derivation", zap.Error(err), zfield.Prefix(prefix))
+		return nil, errors.Wrap(err, "list derivation")
+	}
+
+	derivations := make([]Derivation, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var dv Derivation
+		if err = json.Unmarshal(kv.Value, &dv); nil != err {
+			log.L().Error("unmarshal derivation", zap.Error(err),
+				zfield.Key(string(kv.Key)), zfield.Value(string(kv.Value)))
+			continue
+		}
+		derivations = append(derivations, dv)
+	}
+
+	return derivations, nil
+}
@@ -113,6 +113,18 @@ func (d *Dao) PutEntity(ctx context.Context, eid string, data []byte) error {
 	return errors.Wrap(err, "repo put entity")
 }
 
+// PutEntities upsert Entities in one request.
+func (d *Dao) PutEntities(ctx context.Context, entities map[string][]byte) error {
+	items := make([]*store.StateItem, 0, len(entities))
+	for eid, data := range entities {
+		items = append(items, &store.StateItem{
+			Key:   d.entityCodec.Key(eid),
+			Value: data,
+		})
+	}
+	return errors.Wrap(d.stateClient.BulkSet(ctx, items), "repo put entities")
+}
+
 // GetEntity returns Entity.
 func (d *Dao) GetEntity(ctx context.Context, id string) (_ []byte, err error) {
 	var item *store.StateItem
@@ -0,0 +1,379 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/kit/log"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+)
+
+const (
+	// store history prefix key.
+	HistoryPrefix = "core/v1/histories"
+	// store entity snapshot prefix key.
+	EntitySnapshotPrefix = "core/v1/entitysnapshots"
+	// store history tombstone of deleted entity prefix key.
+	HistoryTombstonePrefix = "core/v1/historytombstones"
+	// core/v1/histories/{entityID}/{version}, version padded for ordering.
+	fmtHistoryString = "%s/%s/%020d"
+	// page size of scanning entity snapshots by timestamp.
+	snapshotScanBatch = 10
+	// max histories put in a transaction, bounded by max operations of etcd transaction.
+	historyTxnBatch = 100
+	// page size of scanning histories and tombstones.
+	historyScanBatch = 500
+)
+
+type HistoryPatch struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// History change record of entity.
+type History struct {
+	EntityID    string         `json:"entity_id"`
+	EntityType  string         `json:"entity_type,omitempty"`
+	EntityOwner string         `json:"entity_owner,omitempty"`
+	Version     int64          `json:"version"`
+	Timestamp   int64          `json:"timestamp"`
+	EventID     string         `json:"event_id"`
+	Sender      string         `json:"sender,omitempty"`
+	Owner       string         `json:"owner,omitempty"`
+	RequestID   string         `json:"request_id,omitempty"`
+	Patches     []HistoryPatch `json:"patches"`
+	// Created histories of previous incarnation of entity purged.
+	Created bool `json:"created,omitempty"`
+	// Deleted tombstone of entity, histories kept until retention expired.
+	Deleted bool `json:"deleted,omitempty"`
+}
+
+// HistoryTombstone index of deleted entity, of which histories expired by retention.
+type HistoryTombstone struct {
+	EntityID  string `json:"entity_id"`
+	Timestamp int64  `json:"timestamp"`
+	// revision of tombstone, histories of entity re-created not expired.
+	Revision int64 `json:"-"`
+}
+
+// WatchHistoryHandler handle history with revision of store, stop watching if error returned.
+type WatchHistoryHandler func(rev int64, h History) error
+
+func (h *History) Key() string {
+	return historyKey(h.EntityID, h.Version)
+}
+
+func historyKey(eid string, version int64) string {
+	return fmt.Sprintf(fmtHistoryString, HistoryPrefix, eid, version)
+}
+
+func historyTombstoneKey(eid string) string {
+	return fmt.Sprintf("%s/%s", HistoryTombstonePrefix, eid)
+}
+
+type ListHistoryReq struct {
+	EntityID     string
+	StartVersion int64
+	EndVersion   int64
+	StartTime    int64
+	EndTime      int64
+	Limit        int64
+}
+
+func (d *Dao) PutHistory(ctx context.Context, h *History) error {
+	var err error
+	var bytes []byte
+	if bytes, err = json.Marshal(h); nil == err {
+		_, err = d.commit(ctx, nil, []clientv3.Op{clientv3.OpPut(h.Key(), string(bytes))}, nil)
+	}
+	return errors.Wrap(err, "put history")
+}
+
+// PutHistories put histories in transactions of batch, tombstones indexed for expiring,
+// histories of previous incarnation purged before entity created.
+func (d *Dao) PutHistories(ctx context.Context, hs []*History) error {
+	ops := make([]clientv3.Op, 0, historyTxnBatch)
+	commit := func() error {
+		if len(ops) == 0 {
+			return nil
+		}
+		_, err := d.commit(ctx, nil, ops, nil)
+		ops = ops[:0]
+		return errors.Wrap(err, "put histories")
+	}
+
+	for _, h := range hs {
+		if h.Created {
+			if err := commit(); nil != err {
+				return err
+			}
+			if err := d.purgeHistory(ctx, h.EntityID); nil != err {
+				return errors.Wrap(err, "put histories")
+			}
+		}
+
+		bytes, err := json.Marshal(h)
+		if nil != err {
+			return errors.Wrap(err, "put histories")
+		}
+		ops = append(ops, clientv3.OpPut(h.Key(), string(bytes)))
+
+		if h.Deleted {
+			if bytes, err = json.Marshal(&HistoryTombstone{
+				EntityID: h.EntityID, Timestamp: h.Timestamp}); nil != err {
+				return errors.Wrap(err, "put histories")
+			}
+			ops = append(ops, clientv3.OpPut(historyTombstoneKey(h.EntityID), string(bytes)))
+		}
+
+		// a tombstone takes two operations.
+		if len(ops) >= historyTxnBatch-1 {
+			if err = commit(); nil != err {
+				return err
+			}
+		}
+	}
+	return commit()
+}
+
+// purgeHistory delete histories and snapshots of deleted entity if tombstone exists.
+func (d *Dao) purgeHistory(ctx context.Context, eid string) error {
+	key := historyTombstoneKey(eid)
+	_, err := d.commit(ctx, []clientv3.Cmp{clientv3.Compare(clientv3.CreateRevision(key), ">", 0)},
+		delHistoryOps(eid), nil)
+	return errors.Wrap(err, "purge history")
+}
+
+// ListHistory list histories in the range of versions, filtered by time if required,
+// scanned in pages until limit reached.
+func (d *Dao) ListHistory(ctx context.Context, req *ListHistoryReq) ([]History, error) {
+	start := historyKey(req.EntityID, req.StartVersion)
+	end := clientv3.GetPrefixRangeEnd(fmt.Sprintf("%s/%s/", HistoryPrefix, req.EntityID))
+	if req.EndVersion > 0 {
+		end = historyKey(req.EntityID, req.EndVersion+1)
+	}
+
+	page := int64(historyScanBatch)
+	if req.Limit > 0 && req.StartTime == 0 && req.EndTime == 0 {
+		page = req.Limit
+	}
+
+	histories := make([]History, 0)
+	for {
+		resp, err := d.etcdEndpoint.Get(ctx, start, clientv3.WithRange(end), clientv3.WithLimit(page))
+		if nil != err {
+			log.L().Error("list history", zap.Error(err), zfield.Eid(req.EntityID))
+			return nil, errors.Wrap(err, "list history")
+		}
+
+		for _, kv := range resp.Kvs {
+			var h History
+			if err = json.Unmarshal(kv.Value, &h); nil != err {
+				log.L().Error("unmarshal history", zap.Error(err),
+					zfield.Key(string(kv.Key)), zfield.Value(string(kv.Value)))
+				continue
+			} else if h.Timestamp < req.StartTime ||
+				(req.EndTime > 0 && h.Timestamp > req.EndTime) {
+				continue
+			}
+
+			histories = append(histories, h)
+			if req.Limit > 0 && int64(len(histories)) >= req.Limit {
+				return histories, nil
+			}
+		}
+
+		if !resp.More || len(resp.Kvs) == 0 {
+			return histories, nil
+		}
+		start = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+	}
+}
+
+// DelHistoryByEntity delete histories, snapshots and tombstone of entity.
+func (d *Dao) DelHistoryByEntity(ctx context.Context, eid string) error {
+	_, err := d.etcdEndpoint.Txn(ctx).Then(delHistoryOps(eid)...).Commit()
+	return errors.Wrap(err, "delete history")
+}
+
+// ExpireHistory delete histories of deleted entity, unless tombstone changed since listed.
+func (d *Dao) ExpireHistory(ctx context.Context, t *HistoryTombstone) error {
+	_, err := d.etcdEndpoint.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(historyTombstoneKey(t.EntityID)), "=", t.Revision)).
+		Then(delHistoryOps(t.EntityID)...).Commit()
+	return errors.Wrap(err, "expire history")
+}
+
+// ListHistoryTombstone list tombstones of entities deleted before timestamp, scanned in pages.
+func (d *Dao) ListHistoryTombstone(ctx context.Context, before int64) ([]HistoryTombstone, error) {
+	var tombstones []HistoryTombstone
+	start := HistoryTombstonePrefix + "/"
+	end := clientv3.GetPrefixRangeEnd(start)
+	for {
+		resp, err := d.etcdEndpoint.Get(ctx, start,
+			clientv3.WithRange(end), clientv3.WithLimit(historyScanBatch))
+		if nil != err {
+			log.L().Error("list history tombstone", zap.Error(err))
+			return nil, errors.Wrap(err, "list history tombstone")
+		}
+
+		for _, kv := range resp.Kvs {
+			var t HistoryTombstone
+			if err = json.Unmarshal(kv.Value, &t); nil != err {
+				log.L().Error("unmarshal history tombstone", zap.Error(err),
+					zfield.Key(string(kv.Key)), zfield.Value(string(kv.Value)))
+				continue
+			} else if t.Timestamp < before {
+				t.Revision = kv.ModRevision
+				tombstones = append(tombstones, t)
+			}
+		}
+
+		if !resp.More || len(resp.Kvs) == 0 {
+			return tombstones, nil
+		}
+		start = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+	}
+}
+
+func delHistoryOps(eid string) []clientv3.Op {
+	return []clientv3.Op{
+		clientv3.OpDelete(fmt.Sprintf("%s/%s/", HistoryPrefix, eid), clientv3.WithPrefix()),
+		clientv3.OpDelete(fmt.Sprintf("%s/%s/", EntitySnapshotPrefix, eid), clientv3.WithPrefix()),
+		clientv3.OpDelete(historyTombstoneKey(eid)),
+	}
+}
+
+// EntitySnapshot full state of entity at version, base of rebuilding entity from histories.
+type EntitySnapshot struct {
+	EntityID  string          `json:"entity_id"`
+	Version   int64           `json:"version"`
+	Timestamp int64           `json:"timestamp"`
+	State     json.RawMessage `json:"state"`
+}
+
+func (s *EntitySnapshot) Key() string {
+	return entitySnapshotKey(s.EntityID, s.Version)
+}
+
+func entitySnapshotKey(eid string, version int64) string {
+	return fmt.Sprintf(fmtHistoryString, EntitySnapshotPrefix, eid, version)
+}
+
+// GetEntitySnapshotReq returns the latest snapshot not after Version and Timestamp, ignored if zero.
+type GetEntitySnapshotReq struct {
+	EntityID  string
+	Version   int64
+	Timestamp int64
+}
+
+// PutEntitySnapshot store snapshot, and trim histories and snapshots which
+// not needed to rebuild the latest limit versions.
+func (d *Dao) PutEntitySnapshot(ctx context.Context, s *EntitySnapshot, limit int64) error {
+	bytes, err := json.Marshal(s)
+	if nil != err {
+		return errors.Wrap(err, "put entity snapshot")
+	}
+
+	ops := []clientv3.Op{clientv3.OpPut(s.Key(), string(bytes))}
+	if limit > 0 && s.Version > limit {
+		// the latest snapshot before retained versions.
+		var resp *clientv3.GetResponse
+		if resp, err = d.etcdEndpoint.Get(ctx, entitySnapshotKey(s.EntityID, 0),
+			clientv3.WithRange(entitySnapshotKey(s.EntityID, s.Version-limit+1)),
+			clientv3.WithSort(clientv3.SortByKey, clientv3.SortDescend),
+			clientv3.WithKeysOnly(), clientv3.WithLimit(1)); nil != err {
+			return errors.Wrap(err, "put entity snapshot, get base snapshot")
+		}
+
+		if len(resp.Kvs) > 0 {
+			base := string(resp.Kvs[0].Key)
+			prefix := fmt.Sprintf("%s/%s/", EntitySnapshotPrefix, s.EntityID)
+			var version int64
+			if _, err = fmt.Sscanf(base[len(prefix):], "%d", &version); nil != err {
+				return errors.Wrap(err, "put entity snapshot, parse base snapshot")
+			}
+
+			ops = append(ops,
+				clientv3.OpDelete(entitySnapshotKey(s.EntityID, 0), clientv3.WithRange(base)),
+				clientv3.OpDelete(historyKey(s.EntityID, 0),
+					clientv3.WithRange(historyKey(s.EntityID, version+1))))
+		}
+	}
+
+	_, err = d.commit(ctx, nil, ops, nil)
+	return errors.Wrap(err, "put entity snapshot")
+}
+
+func (d *Dao) GetEntitySnapshot(ctx context.Context, req *GetEntitySnapshotReq) (*EntitySnapshot, error) {
+	end := clientv3.GetPrefixRangeEnd(fmt.Sprintf("%s/%s/", EntitySnapshotPrefix, req.EntityID))
+	if req.Version > 0 {
+		end = entitySnapshotKey(req.EntityID, req.Version+1)
+	}
+
+	// scan snapshots backward until timestamp matched.
+	for {
+		resp, err := d.etcdEndpoint.Get(ctx, entitySnapshotKey(req.EntityID, 0),
+			clientv3.WithRange(end),
+			clientv3.WithSort(clientv3.SortByKey, clientv3.SortDescend),
+			clientv3.WithLimit(snapshotScanBatch))
+		if nil != err {
+			log.L().Error("get entity snapshot", zap.Error(err), zfield.Eid(req.EntityID))
+			return nil, errors.Wrap(err, "get entity snapshot")
+		}
+
+		for _, kv := range resp.Kvs {
+			var s EntitySnapshot
+			if err = json.Unmarshal(kv.Value, &s); nil != err {
+				log.L().Error("unmarshal entity snapshot", zap.Error(err), zfield.Key(string(kv.Key)))
+				return nil, errors.Wrap(err, "get entity snapshot")
+			} else if req.Timestamp == 0 || s.Timestamp <= req.Timestamp {
+				return &s, nil
+			}
+			end = string(kv.Key)
+		}
+
+		if !resp.More {
+			return nil, xerrors.ErrEntitySnapshotNotFound
+		}
+	}
+}
+
+// WatchHistory watch histories put after revision rev, blocked until ctx done or handler failed.
+func (d *Dao) WatchHistory(ctx context.Context, rev int64, handler WatchHistoryHandler) error {
+	ctx, cancel := context.WithCancel(clientv3.WithRequireLeader(ctx))
+	defer cancel()
+
+	opts := []clientv3.OpOption{clientv3.WithPrefix(), clientv3.WithFilterDelete()}
+	if rev > 0 {
+		opts = append(opts, clientv3.WithRev(rev+1))
+	}
+
+	for wr := range d.etcdEndpoint.Watch(ctx, HistoryPrefix+"/", opts...) {
+		if wr.CompactRevision > 0 {
+			return errors.Wrapf(xerrors.ErrWatchTokenExpired, "compacted revision %d", wr.CompactRevision)
+		} else if err := wr.Err(); nil != err {
+			return errors.Wrap(err, "watch history")
+		}
+
+		for _, ev := range wr.Events {
+			var h History
+			if err := json.Unmarshal(ev.Kv.Value, &h); nil != err {
+				log.L().Error("unmarshal history", zap.Error(err),
+					zfield.Key(string(ev.Kv.Key)), zfield.Value(string(ev.Kv.Value)))
+				continue
+			}
+			if err := handler(ev.Kv.ModRevision, h); nil != err {
+				return errors.Wrap(err, "handle history")
+			}
+		}
+	}
+
+	return errors.Wrap(ctx.Err(), "watch history")
+}
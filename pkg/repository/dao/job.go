@@ -0,0 +1,201 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/kit/log"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+)
+
+const (
+	// store job prefix key.
+	JobPrefix = "core/v1/jobs"
+	// store job failure prefix key.
+	JobFailurePrefix = "core/v1/jobfailures"
+	// core/v1/jobs/{id} .
+	fmtJobString = "%s/%s"
+	// core/v1/jobfailures/{jobID}/{entityID} .
+	fmtJobFailureString = "%s/%s/%s"
+	// jobs and failures of jobs expired 7 days after started.
+	jobTTL = 7 * 24 * 3600
+)
+
+const (
+	JobTypeTemplatePropagate = "template.propagate"
+
+	JobStatusRunning   = "running"
+	JobStatusSucceeded = "succeeded"
+	JobStatusFailed    = "failed"
+)
+
+// Job asynchronous job, such as propagating template scheme to derived entities.
+type Job struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Target     string `json:"target"`
+	Status     string `json:"status"`
+	Total      int64  `json:"total"`
+	Dispatched int64  `json:"dispatched"`
+	// Applied count of entities applied, reported by runtimes which handle the entity.
+	Applied int64 `json:"applied"`
+	// Failed count of entities failed to dispatch or apply.
+	Failed    int64 `json:"failed"`
+	StartTime int64 `json:"start_time"`
+	EndTime   int64 `json:"end_time"`
+}
+
+func (j *Job) Key() string {
+	return fmt.Sprintf(fmtJobString, JobPrefix, j.ID)
+}
+
+// JobFailure failure of job on entity, reported by runtimes which handle the entity.
+type JobFailure struct {
+	JobID     string `json:"job_id"`
+	EntityID  string `json:"entity_id"`
+	Error     string `json:"error"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+func (f *JobFailure) Key() string {
+	return fmt.Sprintf(fmtJobFailureString, JobFailurePrefix, f.JobID, f.EntityID)
+}
+
+type ListJobReq struct {
+	Target string
+	Limit  int64
+}
+
+// PutJob persist job with lease, the job and failures of the job deleted once lease expired.
+func (d *Dao) PutJob(ctx context.Context, job *Job) error {
+	bytes, err := json.Marshal(job)
+	if nil != err {
+		return errors.Wrap(err, "put job")
+	}
+
+	lease, err := d.etcdEndpoint.Grant(ctx, jobTTL)
+	if nil != err {
+		return errors.Wrap(err, "put job, grant lease")
+	}
+	_, err = d.commit(ctx, nil, []clientv3.Op{clientv3.OpPut(job.Key(), string(bytes), clientv3.WithLease(lease.ID))}, nil)
+	return errors.Wrap(err, "put job")
+}
+
+func (d *Dao) GetJob(ctx context.Context, job *Job) (*Job, error) {
+	res, err := d.etcdEndpoint.Get(ctx, job.Key())
+	if nil == err {
+		if len(res.Kvs) == 0 {
+			return job, xerrors.ErrJobNotFound
+		}
+		err = json.Unmarshal(res.Kvs[0].Value, job)
+	}
+	return job, errors.Wrap(err, "get job")
+}
+
+// UpdateJob update job with fn atomically, job updated by runtimes concurrently.
+func (d *Dao) UpdateJob(ctx context.Context, id string, fn func(*Job)) (*Job, error) {
+	key := (&Job{ID: id}).Key()
+	for {
+		res, err := d.etcdEndpoint.Get(ctx, key)
+		if nil != err {
+			return nil, errors.Wrap(err, "update job")
+		} else if len(res.Kvs) == 0 {
+			return nil, errors.Wrap(xerrors.ErrJobNotFound, "update job")
+		}
+
+		var job Job
+		if err = json.Unmarshal(res.Kvs[0].Value, &job); nil != err {
+			return nil, errors.Wrap(err, "update job")
+		}
+
+		fn(&job)
+		bytes, err := json.Marshal(&job)
+		if nil != err {
+			return nil, errors.Wrap(err, "update job")
+		}
+
+		// retry if updated by others since read.
+		txn, err := d.commit(ctx,
+			[]clientv3.Cmp{clientv3.Compare(clientv3.ModRevision(key), "=", res.Kvs[0].ModRevision)},
+			[]clientv3.Op{clientv3.OpPut(key, string(bytes), clientv3.WithIgnoreLease())}, nil)
+		if nil != err {
+			return nil, errors.Wrap(err, "update job")
+		} else if txn.Succeeded {
+			return &job, nil
+		}
+	}
+}
+
+func (d *Dao) ListJob(ctx context.Context, req *ListJobReq) ([]Job, error) {
+	prefix := JobPrefix + "/"
+	resp, err := d.etcdEndpoint.Get(ctx, prefix, clientv3.WithPrefix())
+	if nil != err {
+		log.L().Error("list job", zap.Error(err), zfield.Prefix(prefix))
+		return nil, errors.Wrap(err, "list job")
+	}
+
+	jobs := make([]Job, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var job Job
+		if err = json.Unmarshal(kv.Value, &job); nil != err {
+			log.L().Error("unmarshal job", zap.Error(err),
+				zfield.Key(string(kv.Key)), zfield.Value(string(kv.Value)))
+			continue
+		} else if req.Target != "" && req.Target != job.Target {
+			continue
+		}
+
+		jobs = append(jobs, job)
+		if req.Limit > 0 && int64(len(jobs)) >= req.Limit {
+			break
+		}
+	}
+
+	return jobs, nil
+}
+
+// PutJobFailure persist failure with lease of the job, expired with the job.
+func (d *Dao) PutJobFailure(ctx context.Context, f *JobFailure) error {
+	bytes, err := json.Marshal(f)
+	if nil != err {
+		return errors.Wrap(err, "put job failure")
+	}
+
+	res, err := d.etcdEndpoint.Get(ctx, (&Job{ID: f.JobID}).Key(), clientv3.WithKeysOnly())
+	if nil != err {
+		return errors.Wrap(err, "put job failure, get job")
+	} else if len(res.Kvs) == 0 {
+		return errors.Wrap(xerrors.ErrJobNotFound, "put job failure")
+	}
+
+	_, err = d.commit(ctx, nil, []clientv3.Op{clientv3.OpPut(f.Key(), string(bytes),
+		clientv3.WithLease(clientv3.LeaseID(res.Kvs[0].Lease)))}, nil)
+	return errors.Wrap(err, "put job failure")
+}
+
+func (d *Dao) ListJobFailure(ctx context.Context, jobID string) ([]JobFailure, error) {
+	prefix := fmt.Sprintf("%s/%s/", JobFailurePrefix, jobID)
+	resp, err := d.etcdEndpoint.Get(ctx, prefix, clientv3.WithPrefix())
+	if nil != err {
+		log.L().Error("list job failure", zap.Error(err), zfield.Prefix(prefix))
+		return nil, errors.Wrap(err, "list job failure")
+	}
+
+	failures := make([]JobFailure, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var f JobFailure
+		if err = json.Unmarshal(kv.Value, &f); nil != err {
+			log.L().Error("unmarshal job failure", zap.Error(err),
+				zfield.Key(string(kv.Key)), zfield.Value(string(kv.Value)))
+			continue
+		}
+		failures = append(failures, f)
+	}
+
+	return failures, nil
+}
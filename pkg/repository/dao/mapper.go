@@ -33,6 +33,21 @@ type Mapper struct {
 	Owner       string
 	EntityID    string
 	Description string
+	Windows     []Window
+}
+
+// Window window of mapper input, aggregate of window fed into TQL instead of current value.
+type Window struct {
+	// Input watched key of TQL, entity_id.property_key.
+	Input string
+	// Type tumbling or sliding.
+	Type string
+	// Size seconds of window.
+	Size int64
+	// Aggregate avg, max, min, sum, count or changes.
+	Aggregate string
+	// Emit update or close.
+	Emit string
 }
 
 func (m *Mapper) Copy() Mapper {
@@ -41,6 +56,7 @@ func (m *Mapper) Copy() Mapper {
 		Name:        m.Name,
 		TQL:         m.TQL,
 		Description: m.Description,
+		Windows:     append([]Window(nil), m.Windows...),
 	}
 }
 
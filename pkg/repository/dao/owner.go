@@ -0,0 +1,164 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/kit/log"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+)
+
+const (
+	// store runtime owner prefix key.
+	OwnerPrefix = "core/v1/owners"
+	// core/v1/owners/{runtimeID} .
+	fmtOwnerString = "%s/%s"
+	// timeout of revoking lease after keepalive stopped.
+	revokeTimeout = 3 * time.Second
+)
+
+// Owner node which holds the lease of runtime, only the owner consumes the queue.
+type Owner struct {
+	RuntimeID string `json:"runtime_id"`
+	NodeName  string `json:"node_name"`
+	Lease     int64  `json:"lease"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+func (o *Owner) Key() string {
+	return fmt.Sprintf(fmtOwnerString, OwnerPrefix, o.RuntimeID)
+}
+
+// ownerKey context key of owner guarding writes.
+type ownerKey struct{}
+
+type ownerGuard struct {
+	key   string
+	lease int64
+}
+
+// WithOwner returns ctx of which etcd writes committed only while the runtime owned with the lease,
+// so that writes of a stale owner rejected by etcd even if its fence not revoked yet.
+func WithOwner(ctx context.Context, runtimeID string, lease int64) context.Context {
+	return context.WithValue(ctx, ownerKey{}, &ownerGuard{key: (&Owner{RuntimeID: runtimeID}).Key(), lease: lease})
+}
+
+// commit commit transaction, nested in the comparison of owner lease if ctx guarded by owner,
+// returns ErrRuntimeFenced if the runtime not owned with the lease.
+func (d *Dao) commit(ctx context.Context, cmps []clientv3.Cmp, thenOps, elseOps []clientv3.Op) (*clientv3.TxnResponse, error) {
+	guard, ok := ctx.Value(ownerKey{}).(*ownerGuard)
+	if !ok {
+		res, err := d.etcdEndpoint.Txn(ctx).If(cmps...).Then(thenOps...).Else(elseOps...).Commit()
+		return res, errors.Wrap(err, "commit")
+	}
+
+	res, err := d.etcdEndpoint.Txn(ctx).
+		If(clientv3.Compare(clientv3.LeaseValue(guard.key), "=", guard.lease)).
+		Then(clientv3.OpTxn(cmps, thenOps, elseOps)).Commit()
+	if nil != err {
+		return nil, errors.Wrap(err, "commit")
+	} else if !res.Succeeded {
+		return nil, errors.Wrapf(xerrors.ErrRuntimeFenced, "commit, lease %d", guard.lease)
+	}
+
+	return (*clientv3.TxnResponse)(res.Responses[0].GetResponseTxn()), nil
+}
+
+// GrantExpiry grant lease never kept alive, keys attached deleted once ttl seconds expired.
+func (d *Dao) GrantExpiry(ctx context.Context, ttl int64) (int64, error) {
+	lease, err := d.etcdEndpoint.Grant(ctx, ttl)
+	if nil != err {
+		return 0, errors.Wrap(err, "grant expiry")
+	}
+	return int64(lease.ID), nil
+}
+
+// GrantLease grant lease kept alive until ctx canceled, the channel receives the time
+// of the latest keepalive responded, and closed when the lease lost.
+func (d *Dao) GrantLease(ctx context.Context, ttl int64) (int64, <-chan time.Time, error) {
+	lease, err := d.etcdEndpoint.Grant(ctx, ttl)
+	if nil != err {
+		return 0, nil, errors.Wrap(err, "grant lease")
+	}
+
+	ch, err := d.etcdEndpoint.KeepAlive(ctx, lease.ID)
+	if nil != err {
+		return 0, nil, errors.Wrap(err, "keepalive lease")
+	}
+
+	alive := make(chan time.Time, 1)
+	go func() {
+		for range ch {
+			// replace the time not received yet, never blocks keepalive.
+			select {
+			case <-alive:
+			default:
+			}
+			alive <- time.Now()
+		}
+
+		// release keys attached as soon as possible.
+		close(alive)
+		rctx, cancel := context.WithTimeout(context.Background(), revokeTimeout)
+		defer cancel()
+		if _, err := d.etcdEndpoint.Revoke(rctx, lease.ID); nil != err {
+			log.L().Warn("revoke lease", zap.Error(err), zfield.Lease(int64(lease.ID)))
+		}
+	}()
+
+	return int64(lease.ID), alive, nil
+}
+
+// AcquireOwner own runtime with the lease if unowned, returns the owner.
+func (d *Dao) AcquireOwner(ctx context.Context, o *Owner) (*Owner, error) {
+	bytes, err := json.Marshal(o)
+	if nil != err {
+		return nil, errors.Wrap(err, "acquire owner")
+	}
+
+	res, err := d.etcdEndpoint.Txn(ctx).
+		If(clientv3.Compare(clientv3.CreateRevision(o.Key()), "=", 0)).
+		Then(clientv3.OpPut(o.Key(), string(bytes), clientv3.WithLease(clientv3.LeaseID(o.Lease)))).
+		Else(clientv3.OpGet(o.Key())).Commit()
+	if nil != err {
+		return nil, errors.Wrap(err, "acquire owner")
+	} else if res.Succeeded {
+		return o, nil
+	}
+
+	var owner Owner
+	kvs := res.Responses[0].GetResponseRange().Kvs
+	if len(kvs) == 0 {
+		// released just now, retry later.
+		return &owner, nil
+	} else if err = json.Unmarshal(kvs[0].Value, &owner); nil != err {
+		return nil, errors.Wrap(err, "acquire owner")
+	}
+
+	return &owner, nil
+}
+
+func (d *Dao) ListOwner(ctx context.Context) ([]Owner, error) {
+	res, err := d.etcdEndpoint.Get(ctx, OwnerPrefix+"/", clientv3.WithPrefix())
+	if nil != err {
+		return nil, errors.Wrap(err, "list owner")
+	}
+
+	owners := make([]Owner, 0, len(res.Kvs))
+	for _, kv := range res.Kvs {
+		var owner Owner
+		if err = json.Unmarshal(kv.Value, &owner); nil != err {
+			log.L().Warn("unmarshal owner", zap.Error(err), zfield.Key(string(kv.Key)))
+			continue
+		}
+		owners = append(owners, owner)
+	}
+
+	return owners, nil
+}
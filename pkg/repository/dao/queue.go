@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 	xerrors "github.com/tkeel-io/core/pkg/errors"
@@ -19,6 +20,8 @@ const (
 	QueuePrefix = "CORE.QUEUE"
 	// CORE.QUEUE.{id} .
 	fmtQueueString = "%s.%s"
+	// metadata key of queue url, kafka://host:port/topic/group .
+	QueueMetaURL = "url"
 )
 
 type QueueHandler func([]Queue)
@@ -73,6 +76,12 @@ func (q *Queue) Check() error {
 	return nil
 }
 
+// URL returns pubsub url of queue.
+func (q *Queue) URL() string {
+	url, _ := q.Metadata[QueueMetaURL].(string)
+	return url
+}
+
 func (q *Queue) Key() string {
 	return fmt.Sprintf(fmtQueueString, QueuePrefix, q.ID)
 }
@@ -165,12 +174,13 @@ func (d *Dao) RangeQueue(ctx context.Context, rev int64, handler QueueHandler) {
 
 func (d *Dao) WatchQueue(ctx context.Context, rev int64, handler WatchQueueHandler) {
 	opts := make([]clientv3.OpOption, 0)
-	opts = append(opts, clientv3.WithPrefix(), clientv3.WithRev(rev+1))
+	opts = append(opts, clientv3.WithPrefix(), clientv3.WithRev(rev+1), clientv3.WithPrevKV())
 	resp := d.etcdEndpoint.Watch(ctx, QueuePrefix, opts...)
 
 	for {
 		select {
 		case <-ctx.Done():
+			return
 		case wr := <-resp:
 			if len(wr.Events) == 0 {
 				return
@@ -178,9 +188,20 @@ func (d *Dao) WatchQueue(ctx context.Context, rev int64, handler WatchQueueHandl
 
 			for _, ev := range wr.Events {
 				var queue Queue
-				if err := json.Unmarshal(ev.Kv.Value, &queue); nil != err {
+				kv := ev.Kv
+				if ev.Type == clientv3.EventTypeDelete {
+					// value of deleted key is empty, decode previous value.
+					if nil == ev.PrevKv {
+						queue.ID = strings.TrimPrefix(string(kv.Key), QueuePrefix+".")
+						handler(DELETE, queue)
+						continue
+					}
+					kv = ev.PrevKv
+				}
+
+				if err := json.Unmarshal(kv.Value, &queue); nil != err {
 					log.L().Error("unmarshal queue", zap.Error(err),
-						zfield.Key(string(ev.Kv.Key)), zfield.Value(string(ev.Kv.Value)))
+						zfield.Key(string(kv.Key)), zfield.Value(string(kv.Value)))
 					continue
 				}
 
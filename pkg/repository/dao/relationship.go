@@ -0,0 +1,141 @@
+package dao
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/kit/log"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+)
+
+const (
+	// store relationship prefix key.
+	RelationshipPrefix = "core/v1/relationships"
+	// store reverse relationship prefix key.
+	ReverseRelationshipPrefix = "core/v1/rrelationships"
+	// core/v1/relationships/{source}/{type}/{target} .
+	// core/v1/rrelationships/{target}/{type}/{source} .
+	fmtRelationshipString = "%s/%s/%s/%s"
+)
+
+// Relationship directed typed relationship between entities.
+type Relationship struct {
+	Source     string                 `json:"source"`
+	Type       string                 `json:"type"`
+	Target     string                 `json:"target"`
+	Owner      string                 `json:"owner"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+	CreatedAt  int64                  `json:"created_at"`
+	UpdatedAt  int64                  `json:"updated_at"`
+}
+
+func (r *Relationship) Key() string {
+	return fmt.Sprintf(fmtRelationshipString, RelationshipPrefix, r.Source, r.Type, r.Target)
+}
+
+func (r *Relationship) ReverseKey() string {
+	return fmt.Sprintf(fmtRelationshipString, ReverseRelationshipPrefix, r.Target, r.Type, r.Source)
+}
+
+// ID identify relationship in search engine, hashed since ids of entities may contain '/'.
+func (r *Relationship) ID() string {
+	sum := sha256.Sum256([]byte(r.Source + "\x00" + r.Type + "\x00" + r.Target))
+	return "relationship-" + hex.EncodeToString(sum[:])
+}
+
+type ListRelationshipReq struct {
+	EntityID string
+	// Type all types if empty.
+	Type string
+	// Reverse list relationships which target is the entity.
+	Reverse bool
+}
+
+func (d *Dao) PutRelationship(ctx context.Context, r *Relationship) error {
+	bytes, err := json.Marshal(r)
+	if nil != err {
+		return errors.Wrap(err, "put relationship")
+	}
+
+	// relationship and reverse index updated atomically.
+	_, err = d.etcdEndpoint.Txn(ctx).Then(
+		clientv3.OpPut(r.Key(), string(bytes)),
+		clientv3.OpPut(r.ReverseKey(), string(bytes)),
+	).Commit()
+	return errors.Wrap(err, "put relationship")
+}
+
+func (d *Dao) GetRelationship(ctx context.Context, r *Relationship) (*Relationship, error) {
+	res, err := d.etcdEndpoint.Get(ctx, r.Key())
+	if nil == err {
+		if len(res.Kvs) == 0 {
+			return r, xerrors.ErrRelationshipNotFound
+		}
+		err = json.Unmarshal(res.Kvs[0].Value, r)
+	}
+	return r, errors.Wrap(err, "get relationship")
+}
+
+func (d *Dao) DelRelationship(ctx context.Context, r *Relationship) error {
+	_, err := d.etcdEndpoint.Txn(ctx).Then(
+		clientv3.OpDelete(r.Key()),
+		clientv3.OpDelete(r.ReverseKey()),
+	).Commit()
+	return errors.Wrap(err, "delete relationship")
+}
+
+func (d *Dao) ListRelationship(ctx context.Context, req *ListRelationshipReq) ([]Relationship, error) {
+	prefix := RelationshipPrefix + "/" + req.EntityID + "/"
+	if req.Reverse {
+		prefix = ReverseRelationshipPrefix + "/" + req.EntityID + "/"
+	}
+	if req.Type != "" {
+		prefix += req.Type + "/"
+	}
+
+	resp, err := d.etcdEndpoint.Get(ctx, prefix, clientv3.WithPrefix())
+	if nil != err {
+		log.L().Error("list relationship", zap.Error(err), zfield.Prefix(prefix))
+		return nil, errors.Wrap(err, "list relationship")
+	}
+
+	relationships := make([]Relationship, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var r Relationship
+		if err = json.Unmarshal(kv.Value, &r); nil != err {
+			log.L().Error("unmarshal relationship", zap.Error(err),
+				zfield.Key(string(kv.Key)), zfield.Value(string(kv.Value)))
+			continue
+		}
+		relationships = append(relationships, r)
+	}
+
+	return relationships, nil
+}
+
+// DelRelationshipByEntity delete relationships from or to the entity, returns deleted relationships.
+func (d *Dao) DelRelationshipByEntity(ctx context.Context, eid string) ([]Relationship, error) {
+	var relationships []Relationship
+	for _, reverse := range []bool{false, true} {
+		items, err := d.ListRelationship(ctx, &ListRelationshipReq{EntityID: eid, Reverse: reverse})
+		if nil != err {
+			return relationships, errors.Wrap(err, "delete relationship by entity")
+		}
+
+		for index := range items {
+			if err = d.DelRelationship(ctx, &items[index]); nil != err {
+				return relationships, errors.Wrap(err, "delete relationship by entity")
+			}
+			relationships = append(relationships, items[index])
+		}
+	}
+
+	return relationships, nil
+}
@@ -0,0 +1,66 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/kit/log"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+)
+
+const (
+	// store schedule prefix key.
+	SchedulePrefix = "core/v1/schedules"
+	// core/v1/schedules/{entityID} .
+	fmtScheduleString = "%s/%s"
+)
+
+// Schedule periodic job of entity, such as PERIOD subscription.
+type Schedule struct {
+	ID       string `json:"id"`
+	Interval int64  `json:"interval"` // seconds.
+}
+
+func (s *Schedule) Key() string {
+	return fmt.Sprintf(fmtScheduleString, SchedulePrefix, s.ID)
+}
+
+func (d *Dao) PutSchedule(ctx context.Context, s *Schedule) error {
+	var err error
+	var bytes []byte
+	if bytes, err = json.Marshal(s); nil == err {
+		_, err = d.commit(ctx, nil, []clientv3.Op{clientv3.OpPut(s.Key(), string(bytes))}, nil)
+	}
+	return errors.Wrap(err, "put schedule")
+}
+
+func (d *Dao) DelSchedule(ctx context.Context, s *Schedule) error {
+	_, err := d.commit(ctx, nil, []clientv3.Op{clientv3.OpDelete(s.Key())}, nil)
+	return errors.Wrap(err, "delete schedule")
+}
+
+func (d *Dao) ListSchedule(ctx context.Context) ([]Schedule, error) {
+	prefix := SchedulePrefix + "/"
+	resp, err := d.etcdEndpoint.Get(ctx, prefix, clientv3.WithPrefix())
+	if nil != err {
+		log.L().Error("list schedule", zap.Error(err), zfield.Prefix(prefix))
+		return nil, errors.Wrap(err, "list schedule")
+	}
+
+	schedules := make([]Schedule, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var s Schedule
+		if err = json.Unmarshal(kv.Value, &s); nil != err {
+			log.L().Error("unmarshal schedule", zap.Error(err),
+				zfield.Key(string(kv.Key)), zfield.Value(string(kv.Value)))
+			continue
+		}
+		schedules = append(schedules, s)
+	}
+
+	return schedules, nil
+}
@@ -0,0 +1,40 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/resource/store"
+)
+
+const (
+	// store cache snapshot prefix key.
+	SnapshotPrefix = "CORE.SNAPSHOT"
+	// CORE.SNAPSHOT.{id} .
+	fmtSnapshotString = "%s.%s"
+)
+
+func snapshotKey(id string) string {
+	return fmt.Sprintf(fmtSnapshotString, SnapshotPrefix, id)
+}
+
+// PutSnapshot upsert snapshot.
+func (d *Dao) PutSnapshot(ctx context.Context, id string, data []byte) error {
+	err := d.stateClient.Set(ctx, snapshotKey(id), data)
+	return errors.Wrap(err, "repo put snapshot")
+}
+
+// GetSnapshot returns snapshot.
+func (d *Dao) GetSnapshot(ctx context.Context, id string) (_ []byte, err error) {
+	var item *store.StateItem
+	item, err = d.stateClient.Get(ctx, snapshotKey(id))
+	if nil == err {
+		if len(item.Value) == 0 {
+			return nil, xerrors.ErrSnapshotNotFound
+		}
+		return item.Value, nil
+	}
+	return nil, errors.Wrap(err, "repo get snapshot")
+}
@@ -0,0 +1,138 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/kit/log"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+)
+
+const (
+	// store transaction decision prefix key.
+	TransactionPrefix = "core/v1/transactions"
+	// core/v1/transactions/{txID} .
+	fmtTransactionString = "%s/%s"
+	// decisions expired after all participants resolved.
+	transactionTTL = 600
+
+	// store entity locked by prepared transaction prefix key.
+	TxLockPrefix = "core/v1/txlocks"
+	// core/v1/txlocks/{runtimeID}/{entityID} .
+	fmtTxLockString = "%s/%s/%s"
+)
+
+type TxStatus string
+
+const (
+	TxCommitted TxStatus = "committed"
+	TxAborted   TxStatus = "aborted"
+)
+
+// Transaction decision of multi-entity transaction.
+type Transaction struct {
+	ID        string   `json:"id"`
+	Status    TxStatus `json:"status"`
+	Entities  []string `json:"entities"`
+	Timestamp int64    `json:"timestamp"`
+}
+
+func (t *Transaction) Key() string {
+	return fmt.Sprintf(fmtTransactionString, TransactionPrefix, t.ID)
+}
+
+// DecideTransaction record decision of transaction if undecided, returns the decision recorded.
+func (d *Dao) DecideTransaction(ctx context.Context, t *Transaction) (*Transaction, error) {
+	bytes, err := json.Marshal(t)
+	if nil != err {
+		return nil, errors.Wrap(err, "decide transaction")
+	}
+
+	lease, err := d.etcdEndpoint.Grant(ctx, transactionTTL)
+	if nil != err {
+		return nil, errors.Wrap(err, "decide transaction, grant lease")
+	}
+
+	// first decision wins, coordinator commits or participant aborts.
+	res, err := d.commit(ctx,
+		[]clientv3.Cmp{clientv3.Compare(clientv3.CreateRevision(t.Key()), "=", 0)},
+		[]clientv3.Op{clientv3.OpPut(t.Key(), string(bytes), clientv3.WithLease(lease.ID))},
+		[]clientv3.Op{clientv3.OpGet(t.Key())})
+	if nil != err {
+		return nil, errors.Wrap(err, "decide transaction")
+	} else if res.Succeeded {
+		return t, nil
+	}
+
+	var decided Transaction
+	kvs := res.Responses[0].GetResponseRange().Kvs
+	if len(kvs) == 0 {
+		return nil, errors.Wrap(errors.New("decision expired"), "decide transaction")
+	} else if err = json.Unmarshal(kvs[0].Value, &decided); nil != err {
+		return nil, errors.Wrap(err, "decide transaction")
+	}
+
+	return &decided, nil
+}
+
+// TxPatch patch staged by prepared transaction.
+type TxPatch struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	From  string `json:"from,omitempty"`
+	Value []byte `json:"value,omitempty"`
+}
+
+// TxLock entity locked by prepared transaction, staged patches applied once committed.
+type TxLock struct {
+	TxID      string    `json:"tx_id"`
+	RuntimeID string    `json:"runtime_id"`
+	EntityID  string    `json:"entity_id"`
+	Patches   []TxPatch `json:"patches"`
+	Timestamp int64     `json:"timestamp"`
+}
+
+func (l *TxLock) Key() string {
+	return fmt.Sprintf(fmtTxLockString, TxLockPrefix, l.RuntimeID, l.EntityID)
+}
+
+func (d *Dao) PutTxLock(ctx context.Context, l *TxLock) error {
+	var err error
+	var bytes []byte
+	if bytes, err = json.Marshal(l); nil == err {
+		_, err = d.commit(ctx, nil, []clientv3.Op{clientv3.OpPut(l.Key(), string(bytes))}, nil)
+	}
+	return errors.Wrap(err, "put transaction lock")
+}
+
+func (d *Dao) DelTxLock(ctx context.Context, l *TxLock) error {
+	_, err := d.commit(ctx, nil, []clientv3.Op{clientv3.OpDelete(l.Key())}, nil)
+	return errors.Wrap(err, "delete transaction lock")
+}
+
+// ListTxLock returns entities of runtime locked by prepared transactions.
+func (d *Dao) ListTxLock(ctx context.Context, runtimeID string) ([]TxLock, error) {
+	prefix := fmt.Sprintf("%s/%s/", TxLockPrefix, runtimeID)
+	resp, err := d.etcdEndpoint.Get(ctx, prefix, clientv3.WithPrefix())
+	if nil != err {
+		log.L().Error("list transaction lock", zap.Error(err), zfield.Prefix(prefix))
+		return nil, errors.Wrap(err, "list transaction lock")
+	}
+
+	locks := make([]TxLock, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var l TxLock
+		if err = json.Unmarshal(kv.Value, &l); nil != err {
+			log.L().Error("unmarshal transaction lock", zap.Error(err),
+				zfield.Key(string(kv.Key)), zfield.Value(string(kv.Value)))
+			continue
+		}
+		locks = append(locks, l)
+	}
+
+	return locks, nil
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+)
+
+func (r *repo) PutDeadLetter(ctx context.Context, dl *dao.DeadLetter, lease int64) error {
+	return errors.Wrap(r.dao.PutDeadLetter(ctx, dl, lease), "put dead letter repository")
+}
+
+func (r *repo) CountDeadLetter(ctx context.Context, runtimeID string) (int64, error) {
+	count, err := r.dao.CountDeadLetter(ctx, runtimeID)
+	return count, errors.Wrap(err, "count dead letter repository")
+}
+
+func (r *repo) GetDeadLetter(ctx context.Context, dl *dao.DeadLetter) (*dao.DeadLetter, error) {
+	letter, err := r.dao.GetDeadLetter(ctx, dl)
+	return letter, errors.Wrap(err, "get dead letter repository")
+}
+
+func (r *repo) DelDeadLetter(ctx context.Context, dl *dao.DeadLetter) error {
+	return errors.Wrap(r.dao.DelDeadLetter(ctx, dl), "delete dead letter repository")
+}
+
+func (r *repo) ListDeadLetter(ctx context.Context, req *dao.ListDeadLetterReq) ([]dao.DeadLetter, error) {
+	letters, err := r.dao.ListDeadLetter(ctx, req)
+	return letters, errors.Wrap(err, "list dead letter repository")
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+)
+
+func (r *repo) PutDerivation(ctx context.Context, dv *dao.Derivation) error {
+	return errors.Wrap(r.dao.PutDerivation(ctx, dv), "put derivation repository")
+}
+
+func (r *repo) DelDerivation(ctx context.Context, dv *dao.Derivation) error {
+	return errors.Wrap(r.dao.DelDerivation(ctx, dv), "delete derivation repository")
+}
+
+func (r *repo) ListDerivation(ctx context.Context, templateID string) ([]dao.Derivation, error) {
+	derivations, err := r.dao.ListDerivation(ctx, templateID)
+	return derivations, errors.Wrap(err, "list derivation repository")
+}
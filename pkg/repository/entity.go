@@ -10,6 +10,10 @@ func (r *repo) PutEntity(ctx context.Context, eid string, data []byte) error {
 	return errors.Wrap(r.dao.PutEntity(ctx, eid, data), "put entity repository")
 }
 
+func (r *repo) PutEntities(ctx context.Context, entities map[string][]byte) error {
+	return errors.Wrap(r.dao.PutEntities(ctx, entities), "put entities repository")
+}
+
 func (r *repo) GetEntity(ctx context.Context, eid string) ([]byte, error) {
 	en, err := r.dao.GetEntity(ctx, eid)
 	return en, errors.Wrap(err, "get entity repository")
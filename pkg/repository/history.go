@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+)
+
+func (r *repo) PutHistory(ctx context.Context, h *dao.History) error {
+	return errors.Wrap(r.dao.PutHistory(ctx, h), "put history repository")
+}
+
+func (r *repo) PutHistories(ctx context.Context, hs []*dao.History) error {
+	return errors.Wrap(r.dao.PutHistories(ctx, hs), "put histories repository")
+}
+
+func (r *repo) ListHistory(ctx context.Context, req *dao.ListHistoryReq) ([]dao.History, error) {
+	histories, err := r.dao.ListHistory(ctx, req)
+	return histories, errors.Wrap(err, "list history repository")
+}
+
+func (r *repo) WatchHistory(ctx context.Context, rev int64, handler dao.WatchHistoryHandler) error {
+	return errors.Wrap(r.dao.WatchHistory(ctx, rev, handler), "watch history repository")
+}
+
+func (r *repo) DelHistoryByEntity(ctx context.Context, eid string) error {
+	return errors.Wrap(r.dao.DelHistoryByEntity(ctx, eid), "delete history repository")
+}
+
+func (r *repo) ExpireHistory(ctx context.Context, t *dao.HistoryTombstone) error {
+	return errors.Wrap(r.dao.ExpireHistory(ctx, t), "expire history repository")
+}
+
+func (r *repo) ListHistoryTombstone(ctx context.Context, before int64) ([]dao.HistoryTombstone, error) {
+	tombstones, err := r.dao.ListHistoryTombstone(ctx, before)
+	return tombstones, errors.Wrap(err, "list history tombstone repository")
+}
+
+func (r *repo) PutEntitySnapshot(ctx context.Context, s *dao.EntitySnapshot, limit int64) error {
+	return errors.Wrap(r.dao.PutEntitySnapshot(ctx, s, limit), "put entity snapshot repository")
+}
+
+func (r *repo) GetEntitySnapshot(ctx context.Context, req *dao.GetEntitySnapshotReq) (*dao.EntitySnapshot, error) {
+	s, err := r.dao.GetEntitySnapshot(ctx, req)
+	return s, errors.Wrap(err, "get entity snapshot repository")
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+)
+
+func (r *repo) PutJob(ctx context.Context, job *dao.Job) error {
+	return errors.Wrap(r.dao.PutJob(ctx, job), "put job repository")
+}
+
+func (r *repo) GetJob(ctx context.Context, job *dao.Job) (*dao.Job, error) {
+	ret, err := r.dao.GetJob(ctx, job)
+	return ret, errors.Wrap(err, "get job repository")
+}
+
+func (r *repo) UpdateJob(ctx context.Context, id string, fn func(*dao.Job)) (*dao.Job, error) {
+	job, err := r.dao.UpdateJob(ctx, id, fn)
+	return job, errors.Wrap(err, "update job repository")
+}
+
+func (r *repo) ListJob(ctx context.Context, req *dao.ListJobReq) ([]dao.Job, error) {
+	jobs, err := r.dao.ListJob(ctx, req)
+	return jobs, errors.Wrap(err, "list job repository")
+}
+
+func (r *repo) PutJobFailure(ctx context.Context, f *dao.JobFailure) error {
+	return errors.Wrap(r.dao.PutJobFailure(ctx, f), "put job failure repository")
+}
+
+func (r *repo) ListJobFailure(ctx context.Context, jobID string) ([]dao.JobFailure, error) {
+	failures, err := r.dao.ListJobFailure(ctx, jobID)
+	return failures, errors.Wrap(err, "list job failure repository")
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+)
+
+func (r *repo) GrantExpiry(ctx context.Context, ttl int64) (int64, error) {
+	lease, err := r.dao.GrantExpiry(ctx, ttl)
+	return lease, errors.Wrap(err, "grant expiry repository")
+}
+
+func (r *repo) GrantLease(ctx context.Context, ttl int64) (int64, <-chan time.Time, error) {
+	lease, alive, err := r.dao.GrantLease(ctx, ttl)
+	return lease, alive, errors.Wrap(err, "grant lease repository")
+}
+
+func (r *repo) AcquireOwner(ctx context.Context, o *dao.Owner) (*dao.Owner, error) {
+	owner, err := r.dao.AcquireOwner(ctx, o)
+	return owner, errors.Wrap(err, "acquire owner repository")
+}
+
+func (r *repo) ListOwner(ctx context.Context) ([]dao.Owner, error) {
+	owners, err := r.dao.ListOwner(ctx)
+	return owners, errors.Wrap(err, "list owner repository")
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+)
+
+func (r *repo) PutRelationship(ctx context.Context, rel *dao.Relationship) error {
+	return errors.Wrap(r.dao.PutRelationship(ctx, rel), "put relationship repository")
+}
+
+func (r *repo) GetRelationship(ctx context.Context, rel *dao.Relationship) (*dao.Relationship, error) {
+	ret, err := r.dao.GetRelationship(ctx, rel)
+	return ret, errors.Wrap(err, "get relationship repository")
+}
+
+func (r *repo) DelRelationship(ctx context.Context, rel *dao.Relationship) error {
+	return errors.Wrap(r.dao.DelRelationship(ctx, rel), "delete relationship repository")
+}
+
+func (r *repo) ListRelationship(ctx context.Context, req *dao.ListRelationshipReq) ([]dao.Relationship, error) {
+	relationships, err := r.dao.ListRelationship(ctx, req)
+	return relationships, errors.Wrap(err, "list relationship repository")
+}
+
+func (r *repo) DelRelationshipByEntity(ctx context.Context, eid string) ([]dao.Relationship, error) {
+	relationships, err := r.dao.DelRelationshipByEntity(ctx, eid)
+	return relationships, errors.Wrap(err, "delete relationship by entity repository")
+}
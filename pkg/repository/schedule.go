@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+)
+
+func (r *repo) PutSchedule(ctx context.Context, s *dao.Schedule) error {
+	return errors.Wrap(r.dao.PutSchedule(ctx, s), "put schedule repository")
+}
+
+func (r *repo) DelSchedule(ctx context.Context, s *dao.Schedule) error {
+	return errors.Wrap(r.dao.DelSchedule(ctx, s), "delete schedule repository")
+}
+
+func (r *repo) ListSchedule(ctx context.Context) ([]dao.Schedule, error) {
+	schedules, err := r.dao.ListSchedule(ctx)
+	return schedules, errors.Wrap(err, "list schedule repository")
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+func (r *repo) PutSnapshot(ctx context.Context, id string, data []byte) error {
+	return errors.Wrap(r.dao.PutSnapshot(ctx, id, data), "put snapshot repository")
+}
+
+func (r *repo) GetSnapshot(ctx context.Context, id string) ([]byte, error) {
+	data, err := r.dao.GetSnapshot(ctx, id)
+	return data, errors.Wrap(err, "get snapshot repository")
+}
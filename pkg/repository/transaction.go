@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+)
+
+func (r *repo) DecideTransaction(ctx context.Context, t *dao.Transaction) (*dao.Transaction, error) {
+	decided, err := r.dao.DecideTransaction(ctx, t)
+	return decided, errors.Wrap(err, "decide transaction repository")
+}
+
+func (r *repo) PutTxLock(ctx context.Context, l *dao.TxLock) error {
+	return errors.Wrap(r.dao.PutTxLock(ctx, l), "put transaction lock repository")
+}
+
+func (r *repo) DelTxLock(ctx context.Context, l *dao.TxLock) error {
+	return errors.Wrap(r.dao.DelTxLock(ctx, l), "delete transaction lock repository")
+}
+
+func (r *repo) ListTxLock(ctx context.Context, runtimeID string) ([]dao.TxLock, error) {
+	locks, err := r.dao.ListTxLock(ctx, runtimeID)
+	return locks, errors.Wrap(err, "list transaction lock repository")
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/tkeel-io/core/pkg/repository/dao"
 )
@@ -9,6 +10,7 @@ import (
 type IRepository interface {
 	GetLastRevision(ctx context.Context) int64
 	PutEntity(ctx context.Context, eid string, data []byte) error
+	PutEntities(ctx context.Context, entities map[string][]byte) error
 	GetEntity(ctx context.Context, eid string) ([]byte, error)
 	DelEntity(ctx context.Context, eid string) error
 	HasEntity(ctx context.Context, eid string) (bool, error)
@@ -20,4 +22,51 @@ type IRepository interface {
 	ListMapper(ctx context.Context, rev int64, req *dao.ListMapperReq) ([]dao.Mapper, error)
 	RangeMapper(ctx context.Context, rev int64, handler dao.MapperHandler)
 	WatchMapper(ctx context.Context, rev int64, handler dao.WatchMapperHandler)
+	PutQueue(ctx context.Context, q *dao.Queue) error
+	GetQueue(ctx context.Context, q *dao.Queue) (*dao.Queue, error)
+	DelQueue(ctx context.Context, q *dao.Queue) error
+	HasQueue(ctx context.Context, q *dao.Queue) (bool, error)
+	RangeQueue(ctx context.Context, rev int64, handler dao.QueueHandler)
+	WatchQueue(ctx context.Context, rev int64, handler dao.WatchQueueHandler)
+	PutSnapshot(ctx context.Context, id string, data []byte) error
+	GetSnapshot(ctx context.Context, id string) ([]byte, error)
+	PutDeadLetter(ctx context.Context, dl *dao.DeadLetter, lease int64) error
+	CountDeadLetter(ctx context.Context, runtimeID string) (int64, error)
+	GetDeadLetter(ctx context.Context, dl *dao.DeadLetter) (*dao.DeadLetter, error)
+	DelDeadLetter(ctx context.Context, dl *dao.DeadLetter) error
+	ListDeadLetter(ctx context.Context, req *dao.ListDeadLetterReq) ([]dao.DeadLetter, error)
+	PutSchedule(ctx context.Context, s *dao.Schedule) error
+	DelSchedule(ctx context.Context, s *dao.Schedule) error
+	ListSchedule(ctx context.Context) ([]dao.Schedule, error)
+	PutDerivation(ctx context.Context, dv *dao.Derivation) error
+	DelDerivation(ctx context.Context, dv *dao.Derivation) error
+	ListDerivation(ctx context.Context, templateID string) ([]dao.Derivation, error)
+	PutJob(ctx context.Context, job *dao.Job) error
+	GetJob(ctx context.Context, job *dao.Job) (*dao.Job, error)
+	UpdateJob(ctx context.Context, id string, fn func(*dao.Job)) (*dao.Job, error)
+	ListJob(ctx context.Context, req *dao.ListJobReq) ([]dao.Job, error)
+	PutJobFailure(ctx context.Context, f *dao.JobFailure) error
+	ListJobFailure(ctx context.Context, jobID string) ([]dao.JobFailure, error)
+	PutRelationship(ctx context.Context, r *dao.Relationship) error
+	GetRelationship(ctx context.Context, r *dao.Relationship) (*dao.Relationship, error)
+	DelRelationship(ctx context.Context, r *dao.Relationship) error
+	ListRelationship(ctx context.Context, req *dao.ListRelationshipReq) ([]dao.Relationship, error)
+	DelRelationshipByEntity(ctx context.Context, eid string) ([]dao.Relationship, error)
+	PutHistory(ctx context.Context, h *dao.History) error
+	PutHistories(ctx context.Context, hs []*dao.History) error
+	ListHistory(ctx context.Context, req *dao.ListHistoryReq) ([]dao.History, error)
+	WatchHistory(ctx context.Context, rev int64, handler dao.WatchHistoryHandler) error
+	DelHistoryByEntity(ctx context.Context, eid string) error
+	ExpireHistory(ctx context.Context, t *dao.HistoryTombstone) error
+	ListHistoryTombstone(ctx context.Context, before int64) ([]dao.HistoryTombstone, error)
+	PutEntitySnapshot(ctx context.Context, s *dao.EntitySnapshot, limit int64) error
+	GetEntitySnapshot(ctx context.Context, req *dao.GetEntitySnapshotReq) (*dao.EntitySnapshot, error)
+	DecideTransaction(ctx context.Context, t *dao.Transaction) (*dao.Transaction, error)
+	PutTxLock(ctx context.Context, l *dao.TxLock) error
+	DelTxLock(ctx context.Context, l *dao.TxLock) error
+	ListTxLock(ctx context.Context, runtimeID string) ([]dao.TxLock, error)
+	GrantExpiry(ctx context.Context, ttl int64) (int64, error)
+	GrantLease(ctx context.Context, ttl int64) (int64, <-chan time.Time, error)
+	AcquireOwner(ctx context.Context, o *dao.Owner) (*dao.Owner, error)
+	ListOwner(ctx context.Context) ([]dao.Owner, error)
 }
@@ -9,9 +9,11 @@ import (
 	"time"
 
 	"github.com/Shopify/sarama"
+	"github.com/cenkalti/backoff/v4"
 	"github.com/dapr/kit/retry"
 	"github.com/pkg/errors"
 	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/deadletter"
 	xerrors "github.com/tkeel-io/core/pkg/errors"
 	zfield "github.com/tkeel-io/core/pkg/logger"
 	"github.com/tkeel-io/core/pkg/resource/pubsub"
@@ -19,6 +21,11 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	retryInterval       = time.Second
+	maxDeliveryAttempts = 3
+)
+
 type kafkaMetadata struct {
 	Topic   string   `json:"topic" mapstructure:"topic"`
 	Group   string   `json:"group" mapstructure:"group"`
@@ -113,7 +120,7 @@ func (k *kafkaPubsub) Received(ctx context.Context, receiver pubsub.EventHandler
 
 		for {
 			// Consume the requested topic.
-			if innerError := k.kafkaConsumer.Consume(ctx, []string{k.kafkaMetadata.Topic}, &kafkaConsumer{receiverHandler: receiver}); innerError != nil {
+			if innerError := k.kafkaConsumer.Consume(ctx, []string{k.kafkaMetadata.Topic}, &kafkaConsumer{id: k.id, receiverHandler: receiver}); innerError != nil {
 				log.L().Error("Error closing consumer group", zap.Error(innerError), zfield.Topic(k.kafkaMetadata.Topic),
 					zfield.ID(k.id), zfield.Endpoints(k.kafkaMetadata.Brokers), zfield.Group(k.kafkaMetadata.Group))
 			}
@@ -138,6 +145,7 @@ func (k *kafkaPubsub) Close() error {
 }
 
 type kafkaConsumer struct {
+	id              string
 	receiverHandler pubsub.EventHandler
 }
 
@@ -146,36 +154,57 @@ func (consumer *kafkaConsumer) ConsumeClaim(session sarama.ConsumerGroupSession,
 		return fmt.Errorf("nil consumer callback")
 	}
 
-	backOffConfig := retry.Config{}
-	b := backOffConfig.NewBackOffWithContext(session.Context())
+	backOffConfig := retry.Config{
+		Policy:     retry.PolicyConstant,
+		Duration:   retryInterval,
+		MaxRetries: maxDeliveryAttempts - 1,
+	}
 	for msg := range claim.Messages() {
+		var ev *v1.ProtoEvent
+		var attempts int64
+		b := backOffConfig.NewBackOffWithContext(session.Context())
 		if err := retry.NotifyRecover(func() error {
-			var innerErr error
+			attempts++
 			log.L().Debug("processing kafka message", zfield.Topic(msg.Topic),
 				zfield.Partition(msg.Partition), zfield.Offset(msg.Offset), zfield.Key(string(msg.Key)))
 
-			var ev v1.ProtoEvent
-			if innerErr = v1.Unmarshal(msg.Value, &ev); nil != innerErr {
+			var innerEv v1.ProtoEvent
+			if innerErr := v1.Unmarshal(msg.Value, &innerEv); nil != innerErr {
 				log.L().Error("processing kafka message", zfield.Topic(msg.Topic),
 					zfield.Partition(msg.Partition), zfield.Offset(msg.Offset), zfield.Key(string(msg.Key)))
-				return errors.Wrap(innerErr, "decode event")
-			} else if innerErr = consumer.receiverHandler(session.Context(), &ev); innerErr == nil {
-				session.MarkMessage(msg, "")
+				// undecodable message never succeed.
+				return backoff.Permanent(errors.Wrap(innerErr, "decode event"))
 			}
-			log.L().Error("processing kafka message", zfield.Topic(msg.Topic),
-				zfield.Partition(msg.Partition), zfield.Offset(msg.Offset), zfield.Key(string(msg.Key)))
-			return errors.Wrap(innerErr, "handle message")
+
+			ev = &innerEv
+			return errors.Wrap(consumer.receiverHandler(session.Context(), ev), "handle message")
 		}, b, func(err error, d time.Duration) {
-			log.L().Debug("processing kafka message", zfield.Topic(msg.Topic),
+			log.L().Debug("processing kafka message", zap.Error(err), zfield.Topic(msg.Topic),
 				zfield.Partition(msg.Partition), zfield.Offset(msg.Offset), zfield.Key(string(msg.Key)))
 		}, func() {
 			log.L().Debug("processing kafka message", zfield.Topic(msg.Topic),
 				zfield.Partition(msg.Partition), zfield.Offset(msg.Offset), zfield.Key(string(msg.Key)))
 		}); err != nil {
-			log.L().Error("processing kafka message", zfield.Topic(msg.Topic),
+			log.L().Error("processing kafka message", zap.Error(err), zfield.Topic(msg.Topic),
 				zfield.Partition(msg.Partition), zfield.Offset(msg.Offset), zfield.Key(string(msg.Key)))
-			return errors.Wrap(err, "handle message")
+			if session.Context().Err() != nil {
+				return errors.Wrap(err, "handle message")
+			}
+
+			letter := &deadletter.Letter{RuntimeID: consumer.id, Err: err, Attempts: attempts}
+			if nil != ev {
+				letter.Event = ev
+			} else {
+				letter.Raw = msg.Value
+			}
+
+			// keep message uncommitted if dead letter lost.
+			if err = deadletter.Global().Push(session.Context(), letter); nil != err {
+				return errors.Wrap(err, "push dead letter")
+			}
 		}
+
+		session.MarkMessage(msg, "")
 	}
 
 	return nil
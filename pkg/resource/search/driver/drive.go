@@ -11,6 +11,8 @@ type Type string
 
 type SearchEngine interface {
 	BuildIndex(ctx context.Context, index, content string) error
+	// BulkIndex build indexes of documents keyed by id in one request.
+	BulkIndex(ctx context.Context, docs map[string]string) error
 	Search(ctx context.Context, request SearchRequest) (SearchResponse, error)
 	Delete(ctx context.Context, id string) error
 }
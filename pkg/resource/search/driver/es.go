@@ -95,6 +95,26 @@ func (es *ESClient) BuildIndex(ctx context.Context, index, body string) error {
 	return nil
 }
 
+func (es *ESClient) BulkIndex(ctx context.Context, docs map[string]string) error {
+	bulk := es.Client.Bulk()
+	for id, body := range docs {
+		bulk.Add(elastic.NewBulkIndexRequest().
+			Index(EntityIndex).Id(id).Doc(json.RawMessage(body)))
+	}
+
+	resp, err := bulk.Do(ctx)
+	if nil != err {
+		return errors.Wrap(err, "bulk index in es error")
+	} else if failed := resp.Failed(); len(failed) > 0 {
+		reason := ""
+		if nil != failed[0].Error {
+			reason = failed[0].Error.Reason
+		}
+		return errors.Errorf("bulk index in es error, %d failed, %s", len(failed), reason)
+	}
+	return nil
+}
+
 func (es *ESClient) Delete(ctx context.Context, id string) error {
 	_, err := es.Client.Delete().Index(EntityIndex).Id(id).Do(ctx)
 	if nil != err {
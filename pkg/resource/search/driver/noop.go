@@ -13,6 +13,9 @@ func NewNoopSearchEngine(_ map[string]interface{}) (SearchEngine, error) {
 func (ns *noopSearchEngine) BuildIndex(ctx context.Context, index, content string) error {
 	return nil
 }
+func (ns *noopSearchEngine) BulkIndex(ctx context.Context, docs map[string]string) error {
+	return nil
+}
 func (ns *noopSearchEngine) Search(ctx context.Context, request SearchRequest) (SearchResponse, error) {
 	return SearchResponse{}, nil
 }
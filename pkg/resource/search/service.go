@@ -162,6 +162,20 @@ func (s *Service) IndexBytes(ctx context.Context, id string, jsonData []byte) (o
 	return out, nil
 }
 
+// IndexBulk build indexes of entities keyed by id in one request.
+func (s *Service) IndexBulk(ctx context.Context, docs map[string][]byte) error {
+	engine, ok := s.drivers[s.selectOpt()]
+	if !ok {
+		return errors.New("no specified engine:" + string(s.selectOpt()))
+	}
+
+	contents := make(map[string]string, len(docs))
+	for id, jsonData := range docs {
+		contents[id] = string(jsonData)
+	}
+	return errors.Wrap(engine.BulkIndex(ctx, contents), "bulk build index error")
+}
+
 // Use SelectDriveOption and set the option to this service.
 func (s *Service) Use(opt driver.SelectDriveOption) *Service {
 	s.selectOpt = opt
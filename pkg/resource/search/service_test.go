@@ -50,6 +50,10 @@ func (f fakeEngine) BuildIndex(ctx context.Context, index, content string) error
 	return nil
 }
 
+func (f fakeEngine) BulkIndex(ctx context.Context, docs map[string]string) error {
+	return nil
+}
+
 func (f fakeEngine) Search(ctx context.Context, request driver.SearchRequest) (driver.SearchResponse, error) {
 	return driver.SearchResponse{}, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 
+	daprSDK "github.com/dapr/go-sdk/client"
 	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
 	xerrors "github.com/tkeel-io/core/pkg/errors"
@@ -63,6 +64,26 @@ func (d *daprStore) Set(ctx context.Context, key string, data []byte) error {
 	return errors.Wrap(conn.SaveState(ctx, d.storeName, key, data), "dapr store set")
 }
 
+// BulkSet saves items into store using dapr bulk state api.
+func (d *daprStore) BulkSet(ctx context.Context, items []*store.StateItem) error {
+	var conn dapr.Client
+	if conn = dapr.Get().Select(); nil == conn {
+		log.L().Error("nil connection", zap.Int("items", len(items)),
+			zap.String("store_name", d.storeName), zfield.ID(d.id))
+		return errors.Wrap(xerrors.ErrConnectionNil, "dapr send")
+	}
+
+	stateItems := make([]*daprSDK.SetStateItem, 0, len(items))
+	for _, item := range items {
+		stateItems = append(stateItems, &daprSDK.SetStateItem{
+			Key:      item.Key,
+			Value:    item.Value,
+			Metadata: item.Metadata,
+		})
+	}
+	return errors.Wrap(conn.SaveBulkState(ctx, d.storeName, stateItems...), "dapr store bulk set")
+}
+
 func (d *daprStore) Del(ctx context.Context, key string) error {
 	var conn dapr.Client
 	if conn = dapr.Get().Select(); nil == conn {
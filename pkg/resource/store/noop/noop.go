@@ -28,6 +28,10 @@ func (n *noopStore) Del(ctx context.Context, key string) error {
 	return nil
 }
 
+func (n *noopStore) BulkSet(ctx context.Context, items []*store.StateItem) error {
+	return nil
+}
+
 func init() {
 	zfield.SuccessStatusEvent(os.Stdout, "Register Resource<state.noop> successful")
 	store.Register("noop", func(properties map[string]interface{}) (store.Store, error) {
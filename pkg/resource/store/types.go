@@ -23,6 +23,8 @@ type Store interface {
 	Set(ctx context.Context, key string, data []byte) error
 	// Del delete record from store.
 	Del(ctx context.Context, key string) error
+	// BulkSet saves items into store in one request.
+	BulkSet(ctx context.Context, items []*StateItem) error
 }
 
 var registeredStores = make(map[string]Generator)
@@ -2,43 +2,173 @@ package runtime
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
 
 	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
 	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/kit/log"
 	"github.com/tkeel-io/tdtl"
+	"go.uber.org/zap"
 )
 
+// snapshotChunkSize max entities per chunk of cache snapshot.
+const snapshotChunkSize = 500
+
 type EntityCache interface {
 	Load(ctx context.Context, id string) (Entity, error)
-	Snapshot() error
+	// Capture copy states of cache entities, called within the event loop.
+	Capture() *CacheSnapshot
+	// Persist write states captured into state store in chunks.
+	Persist(ctx context.Context, snap *CacheSnapshot) error
+	Snapshot(ctx context.Context) error
+	Restore(ctx context.Context) error
+}
+
+// CacheSnapshot states of cache entities captured, chunked by entity id.
+type CacheSnapshot struct {
+	chunks []map[string]json.RawMessage
+}
+
+// snapshotManifest stored under id of cache, chunks stored under {id}.{index}.
+type snapshotManifest struct {
+	Chunks int `json:"chunks"`
+}
+
+func snapshotChunkID(id string, index int) string {
+	return fmt.Sprintf("%s.%d", id, index)
 }
 
 type eCache struct {
+	id         string
 	entities   map[string]Entity
 	repository repository.IRepository
+
+	lock sync.RWMutex
 }
 
-func NewCache(repo repository.IRepository) EntityCache {
-	return &eCache{repository: repo,
+func NewCache(id string, repo repository.IRepository) EntityCache {
+	return &eCache{id: id, repository: repo,
 		entities: make(map[string]Entity)}
 }
 
 func (ec *eCache) Load(ctx context.Context, id string) (Entity, error) {
+	ec.lock.RLock()
 	if state, ok := ec.entities[id]; ok {
+		ec.lock.RUnlock()
 		return state, nil
 	}
+	ec.lock.RUnlock()
 
 	// load from state store.
-	cc := tdtl.New([]byte(`{"properties":{}}`))
-	cc.Set("id", tdtl.New(id))
-	en, err := NewEntity(id, cc.Raw())
+	bytes, err := ec.repository.GetEntity(ctx, id)
+	if nil != err || len(bytes) == 0 {
+		if nil != err && !errors.Is(err, xerrors.ErrEntityNotFound) {
+			log.L().Warn("load cache entity from state storage",
+				zfield.Eid(id), zfield.Reason(err.Error()))
+		}
+
+		cc := tdtl.New([]byte(`{"properties":{}}`))
+		cc.Set("id", tdtl.NewString(id))
+		bytes = cc.Raw()
+	}
+
+	en, err := NewEntity(id, bytes)
 	if nil == err {
 		// cache entity.
+		ec.lock.Lock()
 		ec.entities[id] = en
+		ec.lock.Unlock()
 	}
 	return en, errors.Wrap(err, "load cache entity")
 }
 
-func (ec *eCache) Snapshot() error {
-	panic("implement me")
+func (ec *eCache) Capture() *CacheSnapshot {
+	ec.lock.RLock()
+	ids := make([]string, 0, len(ec.entities))
+	for id := range ec.entities {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	snap := &CacheSnapshot{}
+	for index, id := range ids {
+		if index%snapshotChunkSize == 0 {
+			snap.chunks = append(snap.chunks, make(map[string]json.RawMessage, snapshotChunkSize))
+		}
+		// copy state, entities changed by events after captured.
+		snap.chunks[len(snap.chunks)-1][id] = append(json.RawMessage(nil), ec.entities[id].Raw()...)
+	}
+	ec.lock.RUnlock()
+	return snap
+}
+
+func (ec *eCache) Persist(ctx context.Context, snap *CacheSnapshot) error {
+	for index, chunk := range snap.chunks {
+		bytes, err := json.Marshal(chunk)
+		if nil != err {
+			return errors.Wrap(err, "encode cache snapshot")
+		} else if err = ec.repository.PutSnapshot(ctx, snapshotChunkID(ec.id, index), bytes); nil != err {
+			return errors.Wrap(err, "snapshot cache")
+		}
+	}
+
+	// chunks over the manifest left behind, overwritten by later snapshots.
+	bytes, err := json.Marshal(snapshotManifest{Chunks: len(snap.chunks)})
+	if nil != err {
+		return errors.Wrap(err, "encode cache snapshot")
+	}
+	err = ec.repository.PutSnapshot(ctx, ec.id, bytes)
+	return errors.Wrap(err, "snapshot cache")
+}
+
+// Snapshot persist cache entities into state store, the event loop must be stopped.
+func (ec *eCache) Snapshot(ctx context.Context) error {
+	return ec.Persist(ctx, ec.Capture())
+}
+
+// Restore rehydrate cache entities from the last snapshot.
+func (ec *eCache) Restore(ctx context.Context) error {
+	bytes, err := ec.repository.GetSnapshot(ctx, ec.id)
+	if nil != err {
+		if errors.Is(err, xerrors.ErrSnapshotNotFound) {
+			return nil
+		}
+		return errors.Wrap(err, "restore cache")
+	}
+
+	var manifest snapshotManifest
+	if err = json.Unmarshal(bytes, &manifest); nil != err {
+		return errors.Wrap(err, "decode cache snapshot")
+	}
+
+	for index := 0; index < manifest.Chunks; index++ {
+		if bytes, err = ec.repository.GetSnapshot(ctx, snapshotChunkID(ec.id, index)); nil != err {
+			log.L().Warn("restore cache chunk", zap.Error(err), zfield.ID(ec.id), zap.Int("chunk", index))
+			continue
+		}
+
+		states := make(map[string]json.RawMessage)
+		if err = json.Unmarshal(bytes, &states); nil != err {
+			log.L().Warn("decode cache chunk", zap.Error(err), zfield.ID(ec.id), zap.Int("chunk", index))
+			continue
+		}
+
+		ec.lock.Lock()
+		for id, state := range states {
+			en, err := NewEntity(id, state)
+			if nil != err {
+				log.L().Warn("restore cache entity", zap.Error(err), zfield.Eid(id))
+				continue
+			}
+			ec.entities[id] = en
+		}
+		ec.lock.Unlock()
+	}
+
+	return nil
 }
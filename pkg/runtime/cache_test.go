@@ -0,0 +1,75 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/runtime/mock"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/tdtl"
+)
+
+func TestCache_SnapshotRestore(t *testing.T) {
+	repo := mock.NewRepo()
+	ec := NewCache("core-1", repo)
+	assert.Nil(t, ec.Restore(context.TODO()))
+
+	en, err := ec.Load(context.TODO(), "device123")
+	assert.Nil(t, err)
+	assert.Equal(t, "device123", en.Get("id").String())
+	en.Handle(context.TODO(), &Feed{
+		Event:   &v1.ProtoEvent{Metadata: map[string]string{}},
+		Patches: []Patch{{Op: xjson.OpReplace, Path: "properties.temp", Value: tdtl.New("25")}},
+	})
+	for i := 0; i < snapshotChunkSize; i++ {
+		_, err = ec.Load(context.TODO(), fmt.Sprintf("device%04d", i))
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, ec.Snapshot(context.TODO()))
+	assert.Contains(t, repo.Snapshots, "core-1.1")
+
+	// rehydrate another cache from snapshot chunks.
+	ec2 := NewCache("core-1", repo)
+	assert.Nil(t, ec2.Restore(context.TODO()))
+	assert.Len(t, ec2.(*eCache).entities, snapshotChunkSize+1)
+	en2, err := ec2.Load(context.TODO(), "device123")
+	assert.Nil(t, err)
+	assert.Equal(t, "25", en2.GetProp("temp").String())
+}
+
+func TestRuntime_CaptureSnapshot(t *testing.T) {
+	rt, repo := newTestRuntime(mock.NewDispatcher(), 0)
+	rt.snapshotEvery = time.Minute
+	_, err := rt.enCache.Load(context.TODO(), "device123")
+	assert.Nil(t, err)
+
+	// captured within the event loop once interval elapsed.
+	now := time.Now()
+	rt.captureSnapshot(now)
+	assert.Len(t, rt.snapshots, 0)
+	rt.captureSnapshot(now.Add(time.Minute))
+	assert.Len(t, rt.snapshots, 1)
+
+	assert.Nil(t, rt.enCache.Persist(context.TODO(), <-rt.snapshots))
+	assert.Contains(t, repo.Snapshots, "core-1.0")
+}
@@ -0,0 +1,97 @@
+package runtime
+
+import (
+	"strings"
+	"time"
+
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/core/pkg/scheme"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+	"go.uber.org/zap"
+)
+
+const (
+	// SchemeModeStrict reject writes which violate scheme constraints.
+	SchemeModeStrict = "strict"
+	// SchemeModeLenient only log violations, default mode.
+	SchemeModeLenient = "lenient"
+)
+
+// violationLogs throttle logs of violations written in lenient mode, count suppressed ones.
+var violationLogs = newThrottle(time.Minute)
+
+// checkConstraints validate changed properties against entity scheme.
+func checkConstraints(eid string, state *tdtl.Collect, changes []Patch) []scheme.Violation {
+	var violations []scheme.Violation
+	checked := make(map[string]bool)
+	constraints := make(map[string]*scheme.Constraint)
+	for _, change := range changes {
+		if xjson.OpRemove == change.Op {
+			continue
+		}
+
+		var paths []string
+		switch {
+		case FieldProperties == change.Path:
+			state.Get(FieldProperties).Foreach(func(key []byte, _ *tdtl.Collect) {
+				paths = append(paths, FieldProperties+"."+string(key))
+			})
+		case strings.HasPrefix(change.Path, FieldProperties+"."):
+			paths = append(paths, change.Path)
+		}
+
+		for _, path := range paths {
+			segs := strings.Split(strings.TrimPrefix(path, FieldProperties+"."), ".")
+			ct, exists := constraints[segs[0]]
+			if !exists {
+				ct = loadConstraint(eid, state, segs[0])
+				constraints[segs[0]] = ct
+			}
+			if nil == ct {
+				continue
+			}
+
+			// check the deepest constrained value which contains the change.
+			valPath := FieldProperties + "." + segs[0]
+			for _, seg := range segs[1:] {
+				child := ct.Child(seg)
+				if nil == child {
+					break
+				}
+				ct, valPath = child, valPath+"."+seg
+			}
+
+			if !checked[valPath] {
+				checked[valPath] = true
+				violations = append(violations, scheme.Validate(valPath, state.Get(valPath), ct)...)
+			}
+		}
+	}
+
+	return violations
+}
+
+func loadConstraint(eid string, state *tdtl.Collect, propertyID string) *scheme.Constraint {
+	raw := state.Get(FieldScheme + "." + propertyID)
+	if tdtl.Object != raw.Type() {
+		return nil
+	}
+
+	cfg, err := scheme.ParseFrom(raw.Raw())
+	if nil != err {
+		log.L().Warn("parse property scheme", zap.Error(err),
+			zfield.Eid(eid), zfield.Path(propertyID))
+		return nil
+	}
+	return scheme.NewConstraintsFrom(*cfg)
+}
+
+// schemeMode returns scheme mode of entity, empty if not specified.
+func schemeMode(en Entity) string {
+	if mode := en.Get(FieldSchemeMode); tdtl.String == mode.Type() {
+		return mode.String()
+	}
+	return ""
+}
@@ -3,6 +3,7 @@ package runtime
 import (
 	"context"
 	"encoding/json"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
@@ -28,10 +29,15 @@ const (
 	FieldVersion     string = "version"
 	FieldLastTime    string = "last_time"
 	FieldTemplate    string = "template_id"
+	FieldTemplates   string = "templates"
 	FieldScheme      string = "scheme"
+	FieldSchemeMode  string = "scheme_mode"
 	FieldDescription string = "description"
 	FieldProperties  string = "properties"
 	FieldRawData     string = "properties.rawData"
+
+	// templates which inherited scheme entries come from.
+	FieldSchemeOrigins string = "scheme_origins"
 )
 
 type PathConstructor func(pc v1.PathConstructor, destVal, setVal []byte, path string) ([]byte, string, error)
@@ -72,6 +78,23 @@ func (e *entity) Handle(ctx context.Context, feed *Feed) *Feed {
 		return feed
 	}
 
+	// check expected version.
+	if err := checkVersion(e, feed.Event); nil != err {
+		log.L().Warn("update entity", zfield.Eid(e.id),
+			zfield.Reason(err.Error()), zfield.Event(feed.Event))
+		feed.Err = err
+		feed.Patches = []Patch{}
+		feed.State = e.Raw()
+		return feed
+	}
+
+	// nothing changed, version kept.
+	if len(feed.Patches) == 0 {
+		feed.Changes = []Patch{}
+		feed.State = e.Raw()
+		return feed
+	}
+
 	changes := []Patch{}
 	pc := feed.Event.Attr(v1.MetaPathConstructor)
 
@@ -80,7 +103,44 @@ func (e *entity) Handle(ctx context.Context, feed *Feed) *Feed {
 		switch patch.Op {
 		case xjson.OpAdd:
 			cc.Append(patch.Path, patch.Value)
-		case xjson.OpCopy:
+		case xjson.OpTest:
+			// test is a guard, abort whole patches if failed, missing path never equals even null.
+			if val := cc.Get(patch.Path); len(val.Raw()) == 0 || !equalNode(val, patch.Value) {
+				log.L().Warn("patch test failed", zfield.Eid(e.id),
+					zfield.Path(patch.Path), zfield.Event(feed.Event))
+				feed.Err = xerrors.ErrPatchTestFailed
+				feed.Patches = []Patch{}
+				feed.State = e.Raw()
+				return feed
+			}
+		case xjson.OpCopy, xjson.OpMove:
+			// copy without from used to clip result, see service.CopyFrom.
+			if patch.From == "" {
+				continue
+			} else if xjson.OpMove == patch.Op && xjson.IsChildPath(patch.From, patch.Path) {
+				log.L().Warn("patch move into child", zfield.Eid(e.id),
+					zfield.Path(patch.Path), zfield.Event(feed.Event))
+				feed.Err = xerrors.ErrPatchPathInvalid
+				feed.Patches = []Patch{}
+				feed.State = e.Raw()
+				return feed
+			}
+
+			val := cc.Get(patch.From)
+			if tdtl.Null == val.Type() || nil != val.Error() {
+				log.L().Error("patch "+patch.Op.String(), zfield.Eid(e.id), zfield.Path(patch.From),
+					zap.Error(xerrors.ErrPropertyNotFound), zfield.Event(feed.Event))
+				feed.Err = xerrors.ErrPropertyNotFound
+				feed.Patches = []Patch{}
+				feed.State = e.Raw()
+				return feed
+			}
+
+			patch.Value = tdtl.New(val.Raw())
+			if xjson.OpMove == patch.Op {
+				cc.Del(patch.From)
+			}
+			cc.Set(patch.Path, patch.Value)
 		case xjson.OpMerge:
 			var err error
 			mval := cc.Get(patch.Path).Merge(patch.Value)
@@ -124,12 +184,20 @@ func (e *entity) Handle(ctx context.Context, feed *Feed) *Feed {
 		}
 
 		switch patch.Op {
+		case xjson.OpTest:
 		case xjson.OpMerge:
 			patch.Value.Foreach(func(key []byte, value *tdtl.Collect) {
 				changes = append(changes, Patch{
 					Op: xjson.OpReplace, Value: value,
 					Path: strings.Join([]string{patch.Path, string(key)}, ".")})
 			})
+		case xjson.OpCopy:
+			changes = append(changes,
+				Patch{Op: xjson.OpReplace, Path: patch.Path, Value: patch.Value})
+		case xjson.OpMove:
+			changes = append(changes,
+				Patch{Op: xjson.OpRemove, Path: patch.From, Value: tdtl.New([]byte(nil))},
+				Patch{Op: xjson.OpReplace, Path: patch.Path, Value: patch.Value})
 		default:
 			changes = append(changes,
 				Patch{Op: patch.Op, Path: patch.Path, Value: patch.Value})
@@ -137,6 +205,23 @@ func (e *entity) Handle(ctx context.Context, feed *Feed) *Feed {
 	}
 
 	if cc.Error() == nil {
+		markLocalScheme(cc, changes)
+		if violations := checkConstraints(e.id, cc, changes); len(violations) > 0 {
+			err := &scheme.ViolationError{Violations: violations}
+			if SchemeModeStrict == cc.Get(FieldSchemeMode).String() {
+				log.L().Warn("update entity, scheme violated", zfield.Eid(e.id),
+					zfield.Reason(err.Error()), zfield.Event(feed.Event))
+				feed.Err = err
+				feed.Patches = []Patch{}
+				feed.State = e.Raw()
+				return feed
+			}
+			if suppressed, ok := violationLogs.Allow(time.Now()); ok {
+				log.L().Warn("scheme violated", zfield.Eid(e.id),
+					zfield.Reason(err.Error()), zap.Int64("suppressed", suppressed))
+			}
+		}
+
 		e.state = *cc
 		e.Update()
 	} else {
@@ -159,8 +244,9 @@ func (e *entity) Raw() []byte {
 func (e *entity) Copy() Entity {
 	cp := e.state.Copy()
 	return &entity{
-		id:    e.id,
-		state: *cp,
+		id:              e.id,
+		state:           *cp,
+		pathConstructor: e.pathConstructor,
 	}
 }
 
@@ -174,6 +260,7 @@ func (e *entity) Basic() *tdtl.Collect {
 func (e *entity) Tiled() tdtl.Node {
 	basic := e.state.Copy()
 	basic.Del(FieldScheme)
+	basic.Del(FieldSchemeOrigins)
 	basic.Del(FieldProperties)
 	result := basic.Merge(tdtl.New(e.Properties().Raw()))
 	return result
@@ -219,6 +306,33 @@ func (e *entity) Update() {
 	e.state.Set(FieldLastTime, tdtl.NewInt64(lastTime))
 }
 
+// equalNode returns true if the two json values are semantically equal.
+func equalNode(a, b tdtl.Node) bool {
+	var va, vb interface{}
+	if err := json.Unmarshal(a.Raw(), &va); nil != err {
+		return false
+	} else if err = json.Unmarshal(b.Raw(), &vb); nil != err {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// checkVersion returns ErrEntityVersionConflict if event expected version not matched.
+func checkVersion(en Entity, ev v1.Event) error {
+	expected := ev.Attr(v1.MetaExpectedVersion)
+	if expected == "" {
+		return nil
+	}
+
+	version, err := strconv.ParseInt(expected, 10, 64)
+	if nil != err {
+		return xerrors.ErrInvalidRequest
+	} else if version != en.Version() {
+		return xerrors.ErrEntityVersionConflict
+	}
+	return nil
+}
+
 func pathConstructor(pc v1.PathConstructor, destVal, setVal []byte, path string) (_ []byte, _ string, err error) {
 	switch pc {
 	case v1.PCScheme:
@@ -23,6 +23,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
 	xjson "github.com/tkeel-io/core/pkg/util/json"
 	"github.com/tkeel-io/tdtl"
 )
@@ -99,3 +100,167 @@ func TestAdd(t *testing.T) {
 	var v interface{}
 	json.Unmarshal(cc.Raw(), &v)
 }
+
+func TestEntity_HandleExpectedVersion(t *testing.T) {
+	en, err := NewEntity("en-123", []byte(`{"version": 3, "properties": {"temp": 20}}`))
+	assert.Nil(t, err)
+
+	feed := en.Handle(context.TODO(), &Feed{
+		Event: &v1.ProtoEvent{
+			Metadata: map[string]string{v1.MetaExpectedVersion: "2"}},
+		Patches: []Patch{{Path: "properties.temp", Value: tdtl.New("50"), Op: xjson.OpReplace}},
+	})
+	assert.ErrorIs(t, feed.Err, xerrors.ErrEntityVersionConflict)
+	assert.Equal(t, "20", en.GetProp("temp").String())
+
+	feed = en.Handle(context.TODO(), &Feed{
+		Event: &v1.ProtoEvent{
+			Metadata: map[string]string{v1.MetaExpectedVersion: "3"}},
+		Patches: []Patch{{Path: "properties.temp", Value: tdtl.New("50"), Op: xjson.OpReplace}},
+	})
+	assert.Nil(t, feed.Err)
+	assert.Equal(t, "50", en.GetProp("temp").String())
+	assert.Equal(t, int64(4), en.Version())
+
+	// empty patches never bump version.
+	feed = en.Handle(context.TODO(), &Feed{Event: &v1.ProtoEvent{Metadata: map[string]string{}}})
+	assert.Nil(t, feed.Err)
+	assert.Equal(t, int64(4), en.Version())
+}
+
+func TestEntity_HandleTestMoveCopy(t *testing.T) {
+	en, err := NewEntity("en-123", []byte(`{"properties": {"temp": 20, "metrics": {"cpu": 0.5}}}`))
+	assert.Nil(t, err)
+
+	// test failed, abort whole patches.
+	feed := en.Handle(context.TODO(), &Feed{
+		Event: &v1.ProtoEvent{Metadata: map[string]string{}},
+		Patches: []Patch{
+			{Op: xjson.OpReplace, Path: "properties.temp", Value: tdtl.New("30")},
+			{Op: xjson.OpTest, Path: "properties.metrics", Value: tdtl.New(`{"cpu": 0.6}`)},
+		},
+	})
+	assert.ErrorIs(t, feed.Err, xerrors.ErrPatchTestFailed)
+	assert.Equal(t, "20", en.GetProp("temp").String())
+
+	feed = en.Handle(context.TODO(), &Feed{
+		Event: &v1.ProtoEvent{Metadata: map[string]string{}},
+		Patches: []Patch{
+			{Op: xjson.OpTest, Path: "properties.metrics", Value: tdtl.New(`{ "cpu":0.5 }`)},
+			{Op: xjson.OpCopy, From: "properties.temp", Path: "properties.temp2"},
+			{Op: xjson.OpMove, From: "properties.metrics.cpu", Path: "properties.cpu"},
+		},
+	})
+	assert.Nil(t, feed.Err)
+	assert.Equal(t, "20", en.GetProp("temp").String())
+	assert.Equal(t, "20", en.GetProp("temp2").String())
+	assert.Equal(t, "0.5", en.GetProp("cpu").String())
+	assert.Equal(t, `{}`, en.GetProp("metrics").String())
+
+	var paths []string
+	for _, change := range feed.Changes {
+		paths = append(paths, change.Op.String()+":"+change.Path)
+	}
+	assert.Equal(t, []string{"replace:properties.temp2",
+		"remove:properties.metrics.cpu", "replace:properties.cpu"}, paths)
+}
+
+func TestEntity_HandleTestMissingAndMoveIntoChild(t *testing.T) {
+	en, err := NewEntity("en-123", []byte(`{"properties": {"temp": 20, "mode": null, "metrics": {"cpu": 0.5}}}`))
+	assert.Nil(t, err)
+
+	// missing path never equals null.
+	feed := en.Handle(context.TODO(), &Feed{
+		Event: &v1.ProtoEvent{Metadata: map[string]string{}},
+		Patches: []Patch{
+			{Op: xjson.OpTest, Path: "properties.missing", Value: tdtl.New(`null`)},
+			{Op: xjson.OpReplace, Path: "properties.temp", Value: tdtl.New("30")},
+		},
+	})
+	assert.ErrorIs(t, feed.Err, xerrors.ErrPatchTestFailed)
+	assert.Equal(t, "20", en.GetProp("temp").String())
+
+	feed = en.Handle(context.TODO(), &Feed{
+		Event: &v1.ProtoEvent{Metadata: map[string]string{}},
+		Patches: []Patch{
+			{Op: xjson.OpTest, Path: "properties.mode", Value: tdtl.New(`null`)},
+			{Op: xjson.OpReplace, Path: "properties.temp", Value: tdtl.New("30")},
+		},
+	})
+	assert.Nil(t, feed.Err)
+	assert.Equal(t, "30", en.GetProp("temp").String())
+
+	// path can not be moved into its own child.
+	feed = en.Handle(context.TODO(), &Feed{
+		Event: &v1.ProtoEvent{Metadata: map[string]string{}},
+		Patches: []Patch{
+			{Op: xjson.OpMove, From: "properties.metrics", Path: "properties.metrics.old"},
+		},
+	})
+	assert.ErrorIs(t, feed.Err, xerrors.ErrPatchPathInvalid)
+	assert.Equal(t, "0.5", en.GetProp("metrics.cpu").String())
+}
+
+func TestEntity_HandleSchemeConstraint(t *testing.T) {
+	state := `{"properties": {"temp": 20}, "scheme": {
+		"temp": {"id": "temp", "type": "int", "enabled": true, "define": {"min": 0, "max": 100}},
+		"metrics": {"id": "metrics", "type": "struct", "enabled": true, "define": {"fields": {
+			"mode": {"id": "mode", "type": "string", "enabled": true, "define": {"enum": ["auto", "manual"]}}}}}}}`
+	en, err := NewEntity("en-123", []byte(state))
+	assert.Nil(t, err)
+
+	// lenient mode, accept violated writes.
+	feed := en.Handle(context.TODO(), &Feed{
+		Event:   &v1.ProtoEvent{Metadata: map[string]string{}},
+		Patches: []Patch{{Op: xjson.OpReplace, Path: "properties.temp", Value: tdtl.New("200")}},
+	})
+	assert.Nil(t, feed.Err)
+	assert.Equal(t, "200", en.GetProp("temp").String())
+
+	// strict mode, reject violated writes.
+	feed = en.Handle(context.TODO(), &Feed{
+		Event: &v1.ProtoEvent{Metadata: map[string]string{}},
+		Patches: []Patch{
+			{Op: xjson.OpReplace, Path: "scheme_mode", Value: tdtl.New(`"strict"`)},
+			{Op: xjson.OpReplace, Path: "properties.temp", Value: tdtl.New("50.5")},
+			{Op: xjson.OpMerge, Path: "properties", Value: tdtl.New(`{"metrics": {"mode": "off"}}`)},
+		},
+	})
+	assert.ErrorIs(t, feed.Err, xerrors.ErrSchemeViolated)
+	assert.Contains(t, feed.Err.Error(), "properties.temp expect type int")
+	assert.Contains(t, feed.Err.Error(), "properties.metrics.mode not in enum")
+	assert.Equal(t, "200", en.GetProp("temp").String())
+
+	feed = en.Handle(context.TODO(), &Feed{
+		Event: &v1.ProtoEvent{Metadata: map[string]string{}},
+		Patches: []Patch{
+			{Op: xjson.OpReplace, Path: "scheme_mode", Value: tdtl.New(`"strict"`)},
+			{Op: xjson.OpReplace, Path: "properties.temp", Value: tdtl.New("50")},
+			{Op: xjson.OpReplace, Path: "properties.metrics", Value: tdtl.New(`{"mode": "auto"}`)},
+		},
+	})
+	assert.Nil(t, feed.Err)
+	assert.Equal(t, "50", en.GetProp("temp").String())
+	assert.Equal(t, SchemeModeStrict, schemeMode(en))
+}
+
+func TestEntity_HandleLocalScheme(t *testing.T) {
+	state := `{"templates": ["sensor"], "scheme": {"temp": {"type": "int"}, "hum": {"type": "float"}},
+		"scheme_origins": {"temp": "sensor", "hum": "base"}}`
+	en, err := NewEntity("en-123", []byte(state))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"sensor"}, templatesOf(tdtl.New(en.Raw())))
+
+	// override inherited entry.
+	feed := en.Handle(context.TODO(), &Feed{
+		Event:   &v1.ProtoEvent{Metadata: map[string]string{}},
+		Patches: []Patch{{Op: xjson.OpReplace, Path: "scheme.temp", Value: tdtl.New(`{"type": "float"}`)}},
+	})
+	assert.Nil(t, feed.Err)
+	assert.JSONEq(t, `{"hum": "base"}`, en.Get(FieldSchemeOrigins).String())
+
+	node, err := inheritNode(en.ID(), tdtl.New(en.Raw()))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"hum": "base"}, node.Origins)
+	assert.Len(t, node.Scheme, 2)
+}
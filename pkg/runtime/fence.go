@@ -0,0 +1,206 @@
+package runtime
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/core/pkg/resource/tseries"
+	"go.uber.org/atomic"
+)
+
+// fence token of runtime ownership, writes of the runtime rejected once the owner lease lost,
+// so that a stale owner never overwrites states written by the new owner.
+// etcd writes compared with the owner lease by etcd, writes of state store stopped
+// once the lease not kept alive for ttl - ttl/3, before the lease may expire.
+type fence struct {
+	owner   string
+	lease   int64
+	ttl     time.Duration
+	alive   *atomic.Int64
+	revoked *atomic.Bool
+}
+
+func newFence(owner string, lease int64, ttl time.Duration) *fence {
+	return &fence{
+		owner:   owner,
+		lease:   lease,
+		ttl:     ttl,
+		alive:   atomic.NewInt64(time.Now().UnixNano()),
+		revoked: atomic.NewBool(false),
+	}
+}
+
+// Revoke reject writes guarded by the fence.
+func (f *fence) Revoke() {
+	f.revoked.Store(true)
+}
+
+func (f *fence) Revoked() bool {
+	return nil != f && f.revoked.Load()
+}
+
+// KeepAlive record the time of the lease kept alive.
+func (f *fence) KeepAlive(at time.Time) {
+	f.alive.Store(at.UnixNano())
+}
+
+// Check returns error if the fence revoked, nil fence never revoked.
+func (f *fence) Check() error {
+	if f.Revoked() {
+		return errors.Wrapf(xerrors.ErrRuntimeFenced, "lease %d", f.lease)
+	}
+	return nil
+}
+
+// CheckAlive returns error if the fence revoked, or the lease may expire before the write done,
+// guards writes which not compared with the owner lease.
+func (f *fence) CheckAlive() error {
+	if err := f.Check(); nil != err || nil == f || f.ttl <= 0 {
+		return err
+	}
+
+	if elapsed := time.Since(time.Unix(0, f.alive.Load())); elapsed > f.ttl-f.ttl/3 {
+		return errors.Wrapf(xerrors.ErrRuntimeFenced, "lease %d not kept alive for %s", f.lease, elapsed)
+	}
+	return nil
+}
+
+// guard returns ctx of which etcd writes committed only while the runtime owned with the lease.
+func (f *fence) guard(ctx context.Context) context.Context {
+	if nil == f {
+		return ctx
+	}
+	return dao.WithOwner(ctx, f.owner, f.lease)
+}
+
+// guardResource returns entity handlers rejected once the fence revoked.
+func (f *fence) guardResource(erc EntityResource) EntityResource {
+	guard := func(fn EntityResourceFunc) EntityResourceFunc {
+		return func(ctx context.Context, en Entity) error {
+			if err := f.CheckAlive(); nil != err {
+				return err
+			}
+			return fn(ctx, en)
+		}
+	}
+	return EntityResource{FlushHandler: guard(erc.FlushHandler), RemoveHandler: guard(erc.RemoveHandler)}
+}
+
+// guardBulk returns bulk handler rejected once the fence revoked.
+func (f *fence) guardBulk(fn BulkResourceFunc) BulkResourceFunc {
+	return func(ctx context.Context, ens []Entity, points []*tseries.TSeriesData) error {
+		if err := f.CheckAlive(); nil != err {
+			return err
+		}
+		return fn(ctx, ens, points)
+	}
+}
+
+// fencedRepository reject writes of runtime once the fence revoked, etcd writes compared with
+// the owner lease, reads passed through.
+type fencedRepository struct {
+	repository.IRepository
+	fence *fence
+}
+
+func (r *fencedRepository) PutSnapshot(ctx context.Context, id string, data []byte) error {
+	if err := r.fence.CheckAlive(); nil != err {
+		return err
+	}
+	return r.IRepository.PutSnapshot(ctx, id, data)
+}
+
+func (r *fencedRepository) PutSchedule(ctx context.Context, s *dao.Schedule) error {
+	if err := r.fence.Check(); nil != err {
+		return err
+	}
+	return r.IRepository.PutSchedule(r.fence.guard(ctx), s)
+}
+
+func (r *fencedRepository) DelSchedule(ctx context.Context, s *dao.Schedule) error {
+	if err := r.fence.Check(); nil != err {
+		return err
+	}
+	return r.IRepository.DelSchedule(r.fence.guard(ctx), s)
+}
+
+func (r *fencedRepository) PutDerivation(ctx context.Context, dv *dao.Derivation) error {
+	if err := r.fence.Check(); nil != err {
+		return err
+	}
+	return r.IRepository.PutDerivation(r.fence.guard(ctx), dv)
+}
+
+func (r *fencedRepository) DelDerivation(ctx context.Context, dv *dao.Derivation) error {
+	if err := r.fence.Check(); nil != err {
+		return err
+	}
+	return r.IRepository.DelDerivation(r.fence.guard(ctx), dv)
+}
+
+func (r *fencedRepository) PutJob(ctx context.Context, job *dao.Job) error {
+	if err := r.fence.Check(); nil != err {
+		return err
+	}
+	return r.IRepository.PutJob(r.fence.guard(ctx), job)
+}
+
+func (r *fencedRepository) UpdateJob(ctx context.Context, id string, fn func(*dao.Job)) (*dao.Job, error) {
+	if err := r.fence.Check(); nil != err {
+		return nil, err
+	}
+	return r.IRepository.UpdateJob(r.fence.guard(ctx), id, fn)
+}
+
+func (r *fencedRepository) PutJobFailure(ctx context.Context, f *dao.JobFailure) error {
+	if err := r.fence.Check(); nil != err {
+		return err
+	}
+	return r.IRepository.PutJobFailure(r.fence.guard(ctx), f)
+}
+
+func (r *fencedRepository) PutHistory(ctx context.Context, h *dao.History) error {
+	if err := r.fence.Check(); nil != err {
+		return err
+	}
+	return r.IRepository.PutHistory(r.fence.guard(ctx), h)
+}
+
+func (r *fencedRepository) PutHistories(ctx context.Context, hs []*dao.History) error {
+	if err := r.fence.Check(); nil != err {
+		return err
+	}
+	return r.IRepository.PutHistories(r.fence.guard(ctx), hs)
+}
+
+func (r *fencedRepository) PutEntitySnapshot(ctx context.Context, s *dao.EntitySnapshot, limit int64) error {
+	if err := r.fence.Check(); nil != err {
+		return err
+	}
+	return r.IRepository.PutEntitySnapshot(r.fence.guard(ctx), s, limit)
+}
+
+func (r *fencedRepository) DecideTransaction(ctx context.Context, t *dao.Transaction) (*dao.Transaction, error) {
+	if err := r.fence.Check(); nil != err {
+		return nil, err
+	}
+	return r.IRepository.DecideTransaction(r.fence.guard(ctx), t)
+}
+
+func (r *fencedRepository) PutTxLock(ctx context.Context, l *dao.TxLock) error {
+	if err := r.fence.Check(); nil != err {
+		return err
+	}
+	return r.IRepository.PutTxLock(r.fence.guard(ctx), l)
+}
+
+func (r *fencedRepository) DelTxLock(ctx context.Context, l *dao.TxLock) error {
+	if err := r.fence.Check(); nil != err {
+		return err
+	}
+	return r.IRepository.DelTxLock(r.fence.guard(ctx), l)
+}
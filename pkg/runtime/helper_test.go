@@ -0,0 +1,37 @@
+package runtime
+
+import (
+	"context"
+
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/dispatch"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/runtime/mock"
+	"github.com/tkeel-io/core/pkg/types"
+)
+
+// newTestRuntime returns runtime core-1 backed by in memory repository.
+func newTestRuntime(dispatcher dispatch.Dispatcher, maxHops int) (*Runtime, *mock.Repo) {
+	noop := func(context.Context, Entity) error { return nil }
+	repo := mock.NewRepo()
+	rt := NewRuntime(context.Background(), EntityResource{FlushHandler: noop, RemoveHandler: noop},
+		"core-1", dispatcher, repo, 0, maxHops)
+	rt.historyTypes = map[string]bool{HistoryTypeAll: true}
+	return rt, repo
+}
+
+type eventRecorder struct {
+	events []v1.Event
+}
+
+func (d *eventRecorder) Dispatch(_ context.Context, ev v1.Event) error {
+	d.events = append(d.events, ev)
+	return nil
+}
+
+type ownerResource struct {
+	types.ResourceManager
+	repo repository.IRepository
+}
+
+func (r *ownerResource) Repo() repository.IRepository { return r.repo }
@@ -0,0 +1,164 @@
+package runtime
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+	"go.uber.org/zap"
+)
+
+const (
+	// take entity snapshot every batch of histories.
+	historySnapshotBatch = 100
+	// HistoryTypeAll record histories of all entity types.
+	HistoryTypeAll = "*"
+)
+
+// handleHistory record changes of entity as history, and snapshot entity periodically,
+// histories of entity types opted in recorded only, written behind with entities if persister enabled,
+// a tombstone recorded when entity deleted, histories kept until retention expired.
+func (r *Runtime) handleHistory(ctx context.Context, feed *Feed) *Feed {
+	if nil != feed.Err {
+		return feed
+	}
+
+	// versions after created rebuilt from snapshots.
+	created, deleted := false, false
+	if ev, ok := feed.Event.(v1.SystemEvent); ok {
+		switch v1.SystemOp(ev.Action().GetOperator()) {
+		case v1.OpCreate:
+			created = true
+		case v1.OpDelete:
+			deleted = true
+		}
+	}
+
+	if len(feed.Changes) == 0 && !deleted {
+		return feed
+	}
+
+	h := makeHistory(feed)
+	if !r.historyEnabled(h.EntityType) || (deleted && h.Version == 0) {
+		// deleted entity not exists.
+		return feed
+	}
+
+	h.Created, h.Deleted = created, deleted
+	if deleted {
+		// state of deleted entity not changed, tombstone follows the last version.
+		h.Version++
+		h.Timestamp = time.Now().UnixMilli()
+		r.hlock.Lock()
+		delete(r.histories, feed.EntityID)
+		r.hlock.Unlock()
+	}
+
+	var snapshot *dao.EntitySnapshot
+	if !deleted && r.countHistory(ctx, feed.EntityID, created) {
+		snapshot = &dao.EntitySnapshot{
+			EntityID:  h.EntityID,
+			Version:   h.Version,
+			Timestamp: h.Timestamp,
+			State:     feed.State,
+		}
+	}
+
+	if nil != r.persister {
+		if err := r.persister.PutHistory(ctx, h, snapshot); nil != err {
+			log.L().Error("write history behind", zap.Error(err),
+				zfield.Eid(feed.EntityID), zfield.Version(h.Version))
+		}
+		return feed
+	}
+
+	var snapshots []*dao.EntitySnapshot
+	if nil != snapshot {
+		snapshots = append(snapshots, snapshot)
+	}
+	if err := r.flushHistories(ctx, []*dao.History{h}, snapshots); nil != err {
+		log.L().Error("put history", zap.Error(err),
+			zfield.Eid(feed.EntityID), zfield.Version(h.Version))
+	}
+	return feed
+}
+
+// historyEnabled returns true if histories of entity type recorded.
+func (r *Runtime) historyEnabled(entityType string) bool {
+	return r.historyTypes[HistoryTypeAll] || r.historyTypes[entityType]
+}
+
+// flushHistories persist histories, then snapshots which trim histories not retained.
+func (r *Runtime) flushHistories(ctx context.Context, histories []*dao.History, snapshots []*dao.EntitySnapshot) error {
+	if err := r.repository.PutHistories(ctx, histories); nil != err {
+		return errors.Wrap(err, "flush histories")
+	}
+
+	for _, snapshot := range snapshots {
+		if err := r.repository.PutEntitySnapshot(ctx, snapshot, r.historyLimit); nil != err {
+			return errors.Wrap(err, "flush histories, put entity snapshot")
+		}
+	}
+	return nil
+}
+
+// countHistory returns true if snapshot required, counters lost when restarted.
+func (r *Runtime) countHistory(ctx context.Context, eid string, reset bool) bool {
+	r.hlock.Lock()
+	count, counted := r.histories[eid]
+	if reset || count+1 >= historySnapshotBatch {
+		r.histories[eid] = 0
+		r.hlock.Unlock()
+		return true
+	}
+	r.histories[eid] = count + 1
+	r.hlock.Unlock()
+	if counted {
+		return false
+	}
+
+	// entities created before histories recorded have no base snapshot.
+	_, err := r.repository.GetEntitySnapshot(ctx, &dao.GetEntitySnapshotReq{EntityID: eid})
+	if nil != err && !errors.Is(err, xerrors.ErrEntitySnapshotNotFound) {
+		log.L().Warn("get entity snapshot", zap.Error(err), zfield.Eid(eid))
+	}
+	return errors.Is(err, xerrors.ErrEntitySnapshotNotFound)
+}
+
+func makeHistory(feed *Feed) *dao.History {
+	state := tdtl.New(feed.State)
+	h := &dao.History{
+		EntityID:    feed.EntityID,
+		EntityType:  state.Get(FieldType).String(),
+		EntityOwner: state.Get(FieldOwner).String(),
+		Version:     parseInt(state.Get(FieldVersion)),
+		Timestamp:   parseInt(state.Get(FieldLastTime)),
+		EventID:     feed.Event.ID(),
+		Sender:      feed.Event.Attr(v1.MetaSender),
+		Owner:       feed.Event.Attr(v1.MetaOwner),
+		RequestID:   feed.Event.Attr(v1.MetaRequestID),
+		Patches:     make([]dao.HistoryPatch, 0, len(feed.Changes)),
+	}
+
+	for _, change := range feed.Changes {
+		patch := dao.HistoryPatch{Op: change.Op.String(), Path: change.Path}
+		if nil != change.Value && len(change.Value.Raw()) > 0 {
+			patch.Value = change.Value.Raw()
+		}
+		h.Patches = append(h.Patches, patch)
+	}
+
+	return h
+}
+
+func parseInt(node tdtl.Node) int64 {
+	i, _ := strconv.ParseInt(node.String(), 10, 64)
+	return i
+}
@@ -0,0 +1,131 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/core/pkg/resource/tseries"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/tdtl"
+)
+
+func TestRuntime_HandleHistory(t *testing.T) {
+	rt, repo := newTestRuntime(&eventRecorder{}, 0)
+
+	en, err := NewEntity("device123", []byte(`{"version": 3, "properties": {"temp": 20, "hum": 40}}`))
+	assert.Nil(t, err)
+
+	ev := &v1.ProtoEvent{Id: "ev-1", Metadata: map[string]string{
+		v1.MetaOwner: "admin", v1.MetaRequestID: "req-1"}}
+	feed := en.Handle(context.TODO(), &Feed{EntityID: "device123", Event: ev,
+		Patches: []Patch{
+			{Path: "properties.temp", Value: tdtl.New("50"), Op: xjson.OpReplace},
+			{Path: "properties.hum", Value: tdtl.New([]byte(nil)), Op: xjson.OpRemove}}})
+	rt.handleHistory(context.Background(), feed)
+	changedAt := en.LastTime()
+
+	// reads change nothing, no history recorded.
+	feed = en.Handle(context.TODO(), &Feed{EntityID: "device123", Event: ev})
+	rt.handleHistory(context.Background(), feed)
+
+	assert.Len(t, repo.Histories, 1)
+	// base snapshot of entity existed before histories recorded.
+	assert.Len(t, repo.EntitySnapshots, 1)
+	assert.Equal(t, int64(4), repo.EntitySnapshots[0].Version)
+	h := repo.Histories[0]
+	assert.Equal(t, int64(4), h.Version)
+	assert.Equal(t, changedAt, h.Timestamp)
+	assert.Equal(t, "ev-1", h.EventID)
+	assert.Equal(t, "admin", h.Owner)
+	assert.Equal(t, "req-1", h.RequestID)
+	assert.Equal(t, []dao.HistoryPatch{
+		{Op: xjson.OpReplace.String(), Path: "properties.temp", Value: []byte("50")},
+		{Op: xjson.OpRemove.String(), Path: "properties.hum"}}, h.Patches)
+}
+
+func TestRuntime_SnapshotEntity(t *testing.T) {
+	rt, repo := newTestRuntime(&eventRecorder{}, 0)
+
+	// snapshot when created.
+	create := &v1.ProtoEvent{Metadata: map[string]string{}, Data: &v1.ProtoEvent_SystemData{
+		SystemData: &v1.SystemData{Operator: string(v1.OpCreate)}}}
+	changes := []Patch{{Path: "properties.temp", Value: tdtl.New("50"), Op: xjson.OpReplace}}
+	rt.handleHistory(context.Background(), &Feed{EntityID: "device123", Event: create,
+		State: []byte(`{"version":1}`), Changes: changes})
+	assert.Len(t, repo.EntitySnapshots, 1)
+
+	// snapshot every batch of histories.
+	update := &v1.ProtoEvent{Metadata: map[string]string{}}
+	for version := 2; version <= historySnapshotBatch+1; version++ {
+		rt.handleHistory(context.Background(), &Feed{EntityID: "device123", Event: update,
+			State: []byte(fmt.Sprintf(`{"version":%d}`, version)), Changes: changes})
+	}
+	assert.Len(t, repo.Histories, historySnapshotBatch+1)
+	assert.Len(t, repo.EntitySnapshots, 2)
+	assert.Equal(t, int64(historySnapshotBatch+1), repo.EntitySnapshots[1].Version)
+}
+
+func TestRuntime_HandleHistoryTypes(t *testing.T) {
+	rt, repo := newTestRuntime(&eventRecorder{}, 0)
+	rt.historyTypes = map[string]bool{"DEVICE": true}
+
+	ev := &v1.ProtoEvent{Metadata: map[string]string{}}
+	changes := []Patch{{Path: "properties.temp", Value: tdtl.New("50"), Op: xjson.OpReplace}}
+	rt.handleHistory(context.Background(), &Feed{EntityID: "device123", Event: ev,
+		State: []byte(`{"type":"DEVICE","version":2}`), Changes: changes})
+	rt.handleHistory(context.Background(), &Feed{EntityID: "sensor123", Event: ev,
+		State: []byte(`{"type":"SENSOR","version":2}`), Changes: changes})
+
+	// histories of entity types not opted in never recorded.
+	assert.Len(t, repo.Histories, 1)
+	assert.Equal(t, "device123", repo.Histories[0].EntityID)
+}
+
+func TestRuntime_HandleHistoryWriteBehind(t *testing.T) {
+	rt, repo := newTestRuntime(&eventRecorder{}, 0)
+	flushFn := func(context.Context, []Entity, []*tseries.TSeriesData) error { return nil }
+	w, err := newWriteBehind(context.Background(), "core-1", time.Hour, flushFn, rt.flushHistories, rt.markFlushed)
+	assert.Nil(t, err)
+	defer w.Close()
+	rt.persister = w
+
+	ev := &v1.ProtoEvent{Metadata: map[string]string{}}
+	changes := []Patch{{Path: "properties.temp", Value: tdtl.New("50"), Op: xjson.OpReplace}}
+	for version := 2; version <= 3; version++ {
+		rt.handleHistory(context.Background(), &Feed{EntityID: "device123", Event: ev,
+			State: []byte(fmt.Sprintf(`{"version":%d}`, version)), Changes: changes})
+	}
+
+	// histories written behind, persisted in batch once flushed.
+	assert.Len(t, repo.Histories, 0)
+	assert.Nil(t, rt.FlushPending(context.Background()))
+	assert.Len(t, repo.Histories, 2)
+	assert.Len(t, repo.EntitySnapshots, 1)
+}
+
+func TestRuntime_HandleHistoryDeleted(t *testing.T) {
+	rt, repo := newTestRuntime(&eventRecorder{}, 0)
+
+	del := &v1.ProtoEvent{Id: "ev-1", Metadata: map[string]string{v1.MetaOwner: "admin"},
+		Data: &v1.ProtoEvent_SystemData{SystemData: &v1.SystemData{Operator: string(v1.OpDelete)}}}
+	rt.handleHistory(context.Background(), &Feed{EntityID: "device123", Event: del,
+		State: []byte(`{"type":"DEVICE","version":3}`)})
+
+	// deleted entity not exists, no tombstone.
+	rt.handleHistory(context.Background(), &Feed{EntityID: "device456", Event: del,
+		State: []byte(`{"properties":{}}`)})
+
+	assert.Len(t, repo.Histories, 1)
+	assert.Len(t, repo.EntitySnapshots, 0)
+	h := repo.Histories[0]
+	assert.True(t, h.Deleted)
+	assert.Equal(t, int64(4), h.Version)
+	assert.Equal(t, "admin", h.Owner)
+	assert.Empty(t, h.Patches)
+	assert.Contains(t, repo.Tombstones, "device123")
+}
@@ -0,0 +1,216 @@
+package runtime
+
+import (
+	"container/list"
+	"sync"
+
+	"go.uber.org/atomic"
+)
+
+// ResidencyStats runtime entity residency statistics.
+type ResidencyStats struct {
+	Limit     int   `json:"limit"`
+	Resident  int   `json:"resident"`
+	Hits      int64 `json:"hits"`
+	Misses    int64 `json:"misses"`
+	Evictions int64 `json:"evictions"`
+}
+
+type residentEntry struct {
+	id    string
+	dirty bool
+	// writes version bumped by every write, entity flushed only if the version flushed is the latest.
+	writes int64
+	entity Entity
+}
+
+// residency holds runtime entities, evict least recently used flushed entities
+// when the number of resident entities exceeds limit(limit <= 0 means unlimited).
+type residency struct {
+	limit    int
+	elements map[string]*list.Element
+	lru      *list.List
+
+	hits      *atomic.Int64
+	misses    *atomic.Int64
+	evictions *atomic.Int64
+
+	lock sync.Mutex
+}
+
+func newResidency(limit int) *residency {
+	return &residency{
+		limit:     limit,
+		lru:       list.New(),
+		elements:  make(map[string]*list.Element),
+		hits:      atomic.NewInt64(0),
+		misses:    atomic.NewInt64(0),
+		evictions: atomic.NewInt64(0),
+	}
+}
+
+// Get returns resident entity and mark it recently used.
+func (r *residency) Get(id string) (Entity, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	elem, ok := r.elements[id]
+	if !ok {
+		r.misses.Inc()
+		return nil, false
+	}
+
+	r.hits.Inc()
+	r.lru.MoveToFront(elem)
+	entry, _ := elem.Value.(*residentEntry)
+	return entry.entity, true
+}
+
+// GetForUpdate returns resident entity and mark it dirty.
+func (r *residency) GetForUpdate(id string) (Entity, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	elem, ok := r.elements[id]
+	if !ok {
+		r.misses.Inc()
+		return nil, false
+	}
+
+	r.hits.Inc()
+	r.lru.MoveToFront(elem)
+	entry, _ := elem.Value.(*residentEntry)
+	entry.dirty = true
+	entry.writes++
+	return entry.entity, true
+}
+
+// Writes returns write version of resident entity.
+func (r *residency) Writes(id string) int64 {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if elem, ok := r.elements[id]; ok {
+		entry, _ := elem.Value.(*residentEntry)
+		return entry.writes
+	}
+	return 0
+}
+
+// Has returns true if entity resident, without touching statistics.
+func (r *residency) Has(id string) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	_, ok := r.elements[id]
+	return ok
+}
+
+// Put add entity, dirty entity will not be evicted until flushed.
+func (r *residency) Put(id string, en Entity, dirty bool) []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if elem, ok := r.elements[id]; ok {
+		entry, _ := elem.Value.(*residentEntry)
+		entry.entity, entry.dirty = en, dirty
+		if dirty {
+			entry.writes++
+		}
+		r.lru.MoveToFront(elem)
+	} else {
+		entry := &residentEntry{id: id, entity: en, dirty: dirty}
+		if dirty {
+			entry.writes++
+		}
+		r.elements[id] = r.lru.PushFront(entry)
+	}
+	return r.evict()
+}
+
+func (r *residency) Remove(id string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if elem, ok := r.elements[id]; ok {
+		r.lru.Remove(elem)
+		delete(r.elements, id)
+	}
+}
+
+// Invalidate remove flushed entity, which reloaded from state storage next time.
+func (r *residency) Invalidate(id string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if elem, ok := r.elements[id]; ok {
+		if entry, _ := elem.Value.(*residentEntry); !entry.dirty {
+			r.lru.Remove(elem)
+			delete(r.elements, id)
+		}
+	}
+}
+
+// Entities returns resident entities, most recently used first.
+func (r *residency) Entities() []Entity {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	entities := make([]Entity, 0, len(r.elements))
+	for elem := r.lru.Front(); nil != elem; elem = elem.Next() {
+		entry, _ := elem.Value.(*residentEntry)
+		entities = append(entities, entry.entity)
+	}
+	return entities
+}
+
+// DirtyEntities returns resident entities not flushed yet with write versions, most recently used first.
+func (r *residency) DirtyEntities() []residentEntry {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	var entries []residentEntry
+	for elem := r.lru.Front(); nil != elem; elem = elem.Next() {
+		if entry, _ := elem.Value.(*residentEntry); entry.dirty {
+			entries = append(entries, *entry)
+		}
+	}
+	return entries
+}
+
+// MarkFlushed mark entity flushed if written nothing since the write version flushed,
+// returns evicted entity ids.
+func (r *residency) MarkFlushed(id string, writes int64) []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if elem, ok := r.elements[id]; ok {
+		if entry, _ := elem.Value.(*residentEntry); entry.writes == writes {
+			entry.dirty = false
+		}
+	}
+	return r.evict()
+}
+
+func (r *residency) evict() []string {
+	if r.limit <= 0 {
+		return nil
+	}
+
+	var evicted []string
+	elem := r.lru.Back()
+	for len(r.elements) > r.limit && nil != elem {
+		prev := elem.Prev()
+		if entry, _ := elem.Value.(*residentEntry); !entry.dirty {
+			r.lru.Remove(elem)
+			delete(r.elements, entry.id)
+			evicted = append(evicted, entry.id)
+			r.evictions.Inc()
+		}
+		elem = prev
+	}
+	return evicted
+}
+
+func (r *residency) Stats() ResidencyStats {
+	r.lock.Lock()
+	resident := len(r.elements)
+	r.lock.Unlock()
+	return ResidencyStats{
+		Limit:     r.limit,
+		Resident:  resident,
+		Hits:      r.hits.Load(),
+		Misses:    r.misses.Load(),
+		Evictions: r.evictions.Load(),
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResidency(t *testing.T) {
+	r := newResidency(2)
+	assert.Empty(t, r.Put("en-1", DefaultEntity("en-1"), false))
+	assert.Empty(t, r.Put("en-2", DefaultEntity("en-2"), true))
+
+	// en-1 is least recently used and flushed.
+	assert.Equal(t, []string{"en-1"}, r.Put("en-3", DefaultEntity("en-3"), true))
+
+	// dirty entities never evicted.
+	assert.Empty(t, r.Put("en-4", DefaultEntity("en-4"), true))
+	_, ok := r.GetForUpdate("en-2")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"en-3"}, r.MarkFlushed("en-3", r.Writes("en-3")))
+
+	_, ok = r.Get("en-1")
+	assert.False(t, ok)
+	assert.Equal(t, ResidencyStats{Limit: 2, Resident: 2, Hits: 1, Misses: 1, Evictions: 2}, r.Stats())
+}
+
+func TestResidency_Entities(t *testing.T) {
+	r := newResidency(0)
+	r.Put("en-1", DefaultEntity("en-1"), true)
+	r.Put("en-2", DefaultEntity("en-2"), false)
+
+	entities := r.Entities()
+	assert.Len(t, entities, 2)
+	assert.Equal(t, "en-2", entities[0].ID())
+
+	r.Remove("en-2")
+	assert.Len(t, r.Entities(), 1)
+}
+
+func TestResidency_DirtyEntities(t *testing.T) {
+	r := newResidency(0)
+	r.Put("en-1", DefaultEntity("en-1"), true)
+	r.Put("en-2", DefaultEntity("en-2"), false)
+	assert.Len(t, r.DirtyEntities(), 1)
+
+	_, ok := r.GetForUpdate("en-2")
+	assert.True(t, ok)
+	assert.Len(t, r.DirtyEntities(), 2)
+
+	for _, entry := range r.DirtyEntities() {
+		r.MarkFlushed(entry.id, entry.writes)
+	}
+	assert.Empty(t, r.DirtyEntities())
+}
+
+func TestResidency_MarkFlushedWritten(t *testing.T) {
+	r := newResidency(1)
+	r.Put("en-1", DefaultEntity("en-1"), true)
+	writes := r.Writes("en-1")
+
+	// written again before the former write flushed, kept dirty and resident.
+	_, ok := r.GetForUpdate("en-1")
+	assert.True(t, ok)
+	r.MarkFlushed("en-1", writes)
+	assert.Empty(t, r.Put("en-2", DefaultEntity("en-2"), true))
+	assert.True(t, r.Has("en-1"))
+
+	assert.Equal(t, []string{"en-1"}, r.MarkFlushed("en-1", r.Writes("en-1")))
+}
@@ -2,30 +2,585 @@ package mock
 
 import (
 	"context"
+	"sort"
+	"sync"
+	"time"
 
-	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
 	"github.com/tkeel-io/core/pkg/repository/dao"
 )
 
-func NewRepo() repository.IRepository {
-	return &repo{}
+// NewRepo returns repository kept in memory, records exported for assertions.
+func NewRepo() *Repo {
+	return &Repo{
+		Snapshots:     map[string][]byte{},
+		Queues:        map[string]dao.Queue{},
+		DeadLetters:   map[string]dao.DeadLetter{},
+		Schedules:     map[string]dao.Schedule{},
+		Derivations:   map[string]dao.Derivation{},
+		Jobs:          map[string]dao.Job{},
+		Relationships: map[string]dao.Relationship{},
+		Transactions:  map[string]dao.Transaction{},
+		TxLocks:       map[string]dao.TxLock{},
+		Owners:        map[string]dao.Owner{},
+		Tombstones:    map[string]dao.HistoryTombstone{},
+		changed:       make(chan struct{}),
+	}
 }
 
-type repo struct {
+// Repo in memory repository, entities and mappers not stored.
+type Repo struct {
+	// Snapshots runtime snapshots by id.
+	Snapshots map[string][]byte
+	// Queues by id.
+	Queues map[string]dao.Queue
+	// DeadLetters by key.
+	DeadLetters map[string]dao.DeadLetter
+	// Schedules by id.
+	Schedules map[string]dao.Schedule
+	// Derivations by key.
+	Derivations map[string]dao.Derivation
+	// Jobs by id.
+	Jobs        map[string]dao.Job
+	JobFailures []dao.JobFailure
+	// Relationships by key.
+	Relationships map[string]dao.Relationship
+	// Histories in order put, revision of history is index + 1.
+	Histories       []dao.History
+	EntitySnapshots []dao.EntitySnapshot
+	// Tombstones of deleted entities by id.
+	Tombstones map[string]dao.HistoryTombstone
+	// Transactions decisions by id.
+	Transactions map[string]dao.Transaction
+	// TxLocks by key.
+	TxLocks map[string]dao.TxLock
+	// Owners by runtime id.
+	Owners map[string]dao.Owner
+
+	lease   int64
+	changed chan struct{}
+	lock    sync.RWMutex
+}
+
+// GetLastRevision returns revision of the last history.
+func (r *Repo) GetLastRevision(context.Context) int64 {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return int64(len(r.Histories))
 }
 
-func (r *repo) GetLastRevision(context.Context) int64                       { return 0 }
-func (r *repo) PutEntity(context.Context, string, []byte) error             { return nil }
-func (r *repo) GetEntity(context.Context, string) ([]byte, error)           { return nil, nil }
-func (r *repo) DelEntity(context.Context, string) error                     { return nil }
-func (r *repo) HasEntity(context.Context, string) (bool, error)             { return false, nil }
-func (r *repo) PutMapper(context.Context, *dao.Mapper) error                { return nil }
-func (r *repo) GetMapper(context.Context, *dao.Mapper) (*dao.Mapper, error) { return nil, nil }
-func (r *repo) DelMapper(context.Context, *dao.Mapper) error                { return nil }
-func (r *repo) DelMapperByEntity(context.Context, *dao.Mapper) error        { return nil }
-func (r *repo) HasMapper(context.Context, *dao.Mapper) (bool, error)        { return false, nil }
-func (r *repo) ListMapper(context.Context, int64, *dao.ListMapperReq) ([]dao.Mapper, error) {
+func (r *Repo) PutEntity(context.Context, string, []byte) error             { return nil }
+func (r *Repo) PutEntities(context.Context, map[string][]byte) error        { return nil }
+func (r *Repo) GetEntity(context.Context, string) ([]byte, error)           { return nil, nil }
+func (r *Repo) DelEntity(context.Context, string) error                     { return nil }
+func (r *Repo) HasEntity(context.Context, string) (bool, error)             { return false, nil }
+func (r *Repo) PutMapper(context.Context, *dao.Mapper) error                { return nil }
+func (r *Repo) GetMapper(context.Context, *dao.Mapper) (*dao.Mapper, error) { return nil, nil }
+func (r *Repo) DelMapper(context.Context, *dao.Mapper) error                { return nil }
+func (r *Repo) DelMapperByEntity(context.Context, *dao.Mapper) error        { return nil }
+func (r *Repo) HasMapper(context.Context, *dao.Mapper) (bool, error)        { return false, nil }
+func (r *Repo) ListMapper(context.Context, int64, *dao.ListMapperReq) ([]dao.Mapper, error) {
 	return nil, nil
 }
-func (r *repo) RangeMapper(ctx context.Context, rev int64, handler dao.MapperHandler)      {}
-func (r *repo) WatchMapper(ctx context.Context, rev int64, handler dao.WatchMapperHandler) {}
+func (r *Repo) RangeMapper(context.Context, int64, dao.MapperHandler)      {}
+func (r *Repo) WatchMapper(context.Context, int64, dao.WatchMapperHandler) {}
+
+func (r *Repo) PutQueue(_ context.Context, q *dao.Queue) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.Queues[q.ID] = *q
+	return nil
+}
+
+func (r *Repo) GetQueue(_ context.Context, q *dao.Queue) (*dao.Queue, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	if queue, has := r.Queues[q.ID]; has {
+		return &queue, nil
+	}
+	return q, errors.Wrap(xerrors.ErrQueueNotFound, "get queue repository")
+}
+
+func (r *Repo) DelQueue(_ context.Context, q *dao.Queue) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.Queues, q.ID)
+	return nil
+}
+
+func (r *Repo) HasQueue(_ context.Context, q *dao.Queue) (bool, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	_, has := r.Queues[q.ID]
+	return has, nil
+}
+
+func (r *Repo) RangeQueue(_ context.Context, _ int64, handler dao.QueueHandler) {
+	r.lock.RLock()
+	queues := make([]dao.Queue, 0, len(r.Queues))
+	for _, q := range r.Queues {
+		queues = append(queues, q)
+	}
+	r.lock.RUnlock()
+	handler(queues)
+}
+
+// WatchQueue blocked until ctx done.
+func (r *Repo) WatchQueue(ctx context.Context, _ int64, _ dao.WatchQueueHandler) {
+	<-ctx.Done()
+}
+
+func (r *Repo) PutSnapshot(_ context.Context, id string, data []byte) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.Snapshots[id] = data
+	return nil
+}
+
+func (r *Repo) GetSnapshot(_ context.Context, id string) ([]byte, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	if data, has := r.Snapshots[id]; has {
+		return data, nil
+	}
+	return nil, xerrors.ErrSnapshotNotFound
+}
+
+func (r *Repo) PutDeadLetter(_ context.Context, dl *dao.DeadLetter, _ int64) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.DeadLetters[dl.Key()] = *dl
+	return nil
+}
+
+func (r *Repo) CountDeadLetter(_ context.Context, runtimeID string) (int64, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	var count int64
+	for _, dl := range r.DeadLetters {
+		if dl.RuntimeID == runtimeID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *Repo) GetDeadLetter(_ context.Context, dl *dao.DeadLetter) (*dao.DeadLetter, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	if letter, has := r.DeadLetters[dl.Key()]; has {
+		return &letter, nil
+	}
+	return dl, xerrors.ErrDeadLetterNotFound
+}
+
+func (r *Repo) DelDeadLetter(_ context.Context, dl *dao.DeadLetter) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.DeadLetters, dl.Key())
+	return nil
+}
+
+func (r *Repo) ListDeadLetter(_ context.Context, req *dao.ListDeadLetterReq) ([]dao.DeadLetter, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	keys := make([]string, 0, len(r.DeadLetters))
+	for key, dl := range r.DeadLetters {
+		if (req.RuntimeID == "" || req.RuntimeID == dl.RuntimeID) &&
+			(req.After == "" || key > dao.DeadLetterPrefix+"/"+req.After) {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+	letters := make([]dao.DeadLetter, 0, len(keys))
+	for _, key := range keys {
+		if req.Limit > 0 && int64(len(letters)) >= req.Limit {
+			break
+		}
+		letters = append(letters, r.DeadLetters[key])
+	}
+	return letters, nil
+}
+
+func (r *Repo) PutSchedule(_ context.Context, s *dao.Schedule) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.Schedules[s.ID] = *s
+	return nil
+}
+
+func (r *Repo) DelSchedule(_ context.Context, s *dao.Schedule) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.Schedules, s.ID)
+	return nil
+}
+
+func (r *Repo) ListSchedule(context.Context) ([]dao.Schedule, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	schedules := make([]dao.Schedule, 0, len(r.Schedules))
+	for _, s := range r.Schedules {
+		schedules = append(schedules, s)
+	}
+	return schedules, nil
+}
+
+func (r *Repo) PutDerivation(_ context.Context, dv *dao.Derivation) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.Derivations[dv.Key()] = *dv
+	return nil
+}
+
+func (r *Repo) DelDerivation(_ context.Context, dv *dao.Derivation) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.Derivations, dv.Key())
+	return nil
+}
+
+func (r *Repo) ListDerivation(_ context.Context, tid string) ([]dao.Derivation, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	var derivations []dao.Derivation
+	for _, dv := range r.Derivations {
+		if dv.TemplateID == tid {
+			derivations = append(derivations, dv)
+		}
+	}
+	return derivations, nil
+}
+
+func (r *Repo) PutJob(_ context.Context, job *dao.Job) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.Jobs[job.ID] = *job
+	return nil
+}
+
+func (r *Repo) GetJob(_ context.Context, job *dao.Job) (*dao.Job, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	if j, has := r.Jobs[job.ID]; has {
+		return &j, nil
+	}
+	return nil, xerrors.ErrJobNotFound
+}
+
+func (r *Repo) UpdateJob(_ context.Context, id string, fn func(*dao.Job)) (*dao.Job, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	j, has := r.Jobs[id]
+	if !has {
+		return nil, xerrors.ErrJobNotFound
+	}
+	fn(&j)
+	r.Jobs[id] = j
+	return &j, nil
+}
+
+func (r *Repo) ListJob(_ context.Context, req *dao.ListJobReq) ([]dao.Job, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	var jobs []dao.Job
+	for _, job := range r.Jobs {
+		if req.Target == "" || req.Target == job.Target {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs, nil
+}
+
+func (r *Repo) PutJobFailure(_ context.Context, f *dao.JobFailure) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.JobFailures = append(r.JobFailures, *f)
+	return nil
+}
+
+func (r *Repo) ListJobFailure(_ context.Context, jobID string) ([]dao.JobFailure, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	var failures []dao.JobFailure
+	for _, f := range r.JobFailures {
+		if f.JobID == jobID {
+			failures = append(failures, f)
+		}
+	}
+	return failures, nil
+}
+
+func (r *Repo) PutRelationship(_ context.Context, rel *dao.Relationship) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.Relationships[rel.Key()] = *rel
+	return nil
+}
+
+func (r *Repo) GetRelationship(_ context.Context, rel *dao.Relationship) (*dao.Relationship, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	if item, has := r.Relationships[rel.Key()]; has {
+		return &item, nil
+	}
+	return nil, xerrors.ErrRelationshipNotFound
+}
+
+func (r *Repo) DelRelationship(_ context.Context, rel *dao.Relationship) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.Relationships, rel.Key())
+	return nil
+}
+
+func (r *Repo) ListRelationship(_ context.Context, req *dao.ListRelationshipReq) ([]dao.Relationship, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	var items []dao.Relationship
+	for _, item := range r.Relationships {
+		if req.Type != "" && req.Type != item.Type {
+			continue
+		} else if !req.Reverse && item.Source == req.EntityID ||
+			req.Reverse && item.Target == req.EntityID {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+func (r *Repo) DelRelationshipByEntity(_ context.Context, eid string) ([]dao.Relationship, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	var items []dao.Relationship
+	for key, item := range r.Relationships {
+		if item.Source == eid || item.Target == eid {
+			items = append(items, item)
+			delete(r.Relationships, key)
+		}
+	}
+	return items, nil
+}
+
+func (r *Repo) PutHistory(_ context.Context, h *dao.History) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.Histories = append(r.Histories, *h)
+	switch {
+	case h.Created:
+		delete(r.Tombstones, h.EntityID)
+	case h.Deleted:
+		r.Tombstones[h.EntityID] = dao.HistoryTombstone{
+			EntityID: h.EntityID, Timestamp: h.Timestamp, Revision: int64(len(r.Histories))}
+	}
+	// wake up watchers.
+	close(r.changed)
+	r.changed = make(chan struct{})
+	return nil
+}
+
+func (r *Repo) PutHistories(ctx context.Context, hs []*dao.History) error {
+	for _, h := range hs {
+		if err := r.PutHistory(ctx, h); nil != err {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Repo) ListHistory(_ context.Context, req *dao.ListHistoryReq) ([]dao.History, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	var histories []dao.History
+	for _, h := range r.Histories {
+		switch {
+		case h.EntityID != req.EntityID,
+			h.Version < req.StartVersion,
+			req.EndVersion > 0 && h.Version > req.EndVersion,
+			h.Timestamp < req.StartTime,
+			req.EndTime > 0 && h.Timestamp > req.EndTime:
+			continue
+		}
+		histories = append(histories, h)
+	}
+
+	sort.SliceStable(histories, func(i, j int) bool {
+		return histories[i].Version < histories[j].Version
+	})
+	if req.Limit > 0 && int64(len(histories)) > req.Limit {
+		histories = histories[:req.Limit]
+	}
+	return histories, nil
+}
+
+// WatchHistory replay histories after rev, then watch histories put until ctx done.
+func (r *Repo) WatchHistory(ctx context.Context, rev int64, handler dao.WatchHistoryHandler) error {
+	for {
+		r.lock.RLock()
+		histories := r.Histories[rev:]
+		changed := r.changed
+		r.lock.RUnlock()
+
+		for _, h := range histories {
+			rev++
+			if err := handler(rev, h); nil != err {
+				return errors.Wrap(err, "handle history")
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "watch history")
+		case <-changed:
+		}
+	}
+}
+
+func (r *Repo) DelHistoryByEntity(_ context.Context, eid string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.delHistory(eid)
+	return nil
+}
+
+func (r *Repo) ExpireHistory(_ context.Context, t *dao.HistoryTombstone) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.Tombstones[t.EntityID].Revision == t.Revision {
+		r.delHistory(t.EntityID)
+	}
+	return nil
+}
+
+func (r *Repo) ListHistoryTombstone(_ context.Context, before int64) ([]dao.HistoryTombstone, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	var tombstones []dao.HistoryTombstone
+	for _, t := range r.Tombstones {
+		if t.Timestamp < before {
+			tombstones = append(tombstones, t)
+		}
+	}
+	return tombstones, nil
+}
+
+// delHistory delete snapshots and tombstone, histories kept for revisions of watching.
+func (r *Repo) delHistory(eid string) {
+	snapshots := r.EntitySnapshots[:0]
+	for _, s := range r.EntitySnapshots {
+		if s.EntityID != eid {
+			snapshots = append(snapshots, s)
+		}
+	}
+	r.EntitySnapshots = snapshots
+	delete(r.Tombstones, eid)
+}
+
+func (r *Repo) PutEntitySnapshot(_ context.Context, s *dao.EntitySnapshot, _ int64) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.EntitySnapshots = append(r.EntitySnapshots, *s)
+	return nil
+}
+
+// GetEntitySnapshot returns the latest snapshot not after version and timestamp.
+func (r *Repo) GetEntitySnapshot(_ context.Context, req *dao.GetEntitySnapshotReq) (*dao.EntitySnapshot, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	var latest *dao.EntitySnapshot
+	for index := range r.EntitySnapshots {
+		s := &r.EntitySnapshots[index]
+		switch {
+		case s.EntityID != req.EntityID,
+			req.Version > 0 && s.Version > req.Version,
+			req.Timestamp > 0 && s.Timestamp > req.Timestamp:
+			continue
+		}
+		if nil == latest || s.Version > latest.Version {
+			latest = s
+		}
+	}
+
+	if nil == latest {
+		return nil, xerrors.ErrEntitySnapshotNotFound
+	}
+	snapshot := *latest
+	return &snapshot, nil
+}
+
+func (r *Repo) DecideTransaction(_ context.Context, t *dao.Transaction) (*dao.Transaction, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if decided, has := r.Transactions[t.ID]; has {
+		return &decided, nil
+	}
+	r.Transactions[t.ID] = *t
+	return t, nil
+}
+
+func (r *Repo) PutTxLock(_ context.Context, l *dao.TxLock) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.TxLocks[l.Key()] = *l
+	return nil
+}
+
+func (r *Repo) DelTxLock(_ context.Context, l *dao.TxLock) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.TxLocks, l.Key())
+	return nil
+}
+
+func (r *Repo) ListTxLock(_ context.Context, runtimeID string) ([]dao.TxLock, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	var locks []dao.TxLock
+	for _, l := range r.TxLocks {
+		if l.RuntimeID == runtimeID {
+			locks = append(locks, l)
+		}
+	}
+	return locks, nil
+}
+
+// GrantExpiry grant lease never expired.
+func (r *Repo) GrantExpiry(context.Context, int64) (int64, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.lease++
+	return r.lease, nil
+}
+
+// GrantLease grant lease never lost.
+func (r *Repo) GrantLease(context.Context, int64) (int64, <-chan time.Time, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.lease++
+	return r.lease, nil, nil
+}
+
+func (r *Repo) AcquireOwner(_ context.Context, o *dao.Owner) (*dao.Owner, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if owner, has := r.Owners[o.RuntimeID]; has {
+		return &owner, nil
+	}
+	r.Owners[o.RuntimeID] = *o
+	return o, nil
+}
+
+func (r *Repo) ListOwner(context.Context) ([]dao.Owner, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	owners := make([]dao.Owner, 0, len(r.Owners))
+	for _, o := range r.Owners {
+		owners = append(owners, o)
+	}
+	sort.Slice(owners, func(i, j int) bool {
+		return owners[i].RuntimeID < owners[j].RuntimeID
+	})
+	return owners, nil
+}
@@ -3,12 +3,14 @@ package runtime
 import (
 	"context"
 	"encoding/json"
+	"os"
 	"sync"
 	"time"
 
 	"github.com/Shopify/sarama"
 	"github.com/pkg/errors"
 	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/deadletter"
 	"github.com/tkeel-io/core/pkg/dispatch"
 	xerrors "github.com/tkeel-io/core/pkg/errors"
 	zfield "github.com/tkeel-io/core/pkg/logger"
@@ -23,18 +25,56 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	defaultSnapshotInterval = 30 * time.Second
+	defaultMaxEventHops     = 16
+	defaultHistoryLimit     = 1000
+	// histories of deleted entities kept 30 days by default.
+	defaultHistoryRetention = 30 * 24 * time.Hour
+	// interval of expiring histories of deleted entities.
+	historyExpireInterval = time.Hour
+)
+
 type NodeConf struct {
-	Sources []string
+	// NodeName runtimes of queues assigned to the node created, static sources only if empty.
+	NodeName         string
+	Sources          []string
+	SnapshotInterval time.Duration
+	// ResidencyLimit max resident entities per runtime, unlimited if zero.
+	ResidencyLimit int
+	// DeadLetterSink pubsub url which dead letters forwarded to, optional.
+	DeadLetterSink string
+	// MaxEventHops max times an event derived by mappers, default 16.
+	MaxEventHops int
+	// HistoryLimit max change histories kept per entity, default 1000, unlimited if negative.
+	HistoryLimit int
+	// HistoryTypes entity types of which change histories recorded, all types if contains "*", none if empty.
+	HistoryTypes []string
+	// HistoryRetention duration histories of deleted entities kept, default 30 days.
+	HistoryRetention time.Duration
+	// OwnerTTL lease ttl of runtime ownership, default 10s.
+	OwnerTTL time.Duration
+	// PersistWindow window within which entity writes coalesced, default 100ms, written through if negative.
+	PersistWindow time.Duration
+	// Stages custom stages of entity event handling.
+	Stages []StageConf
 }
 
 type Node struct {
+	// name of the node owning runtimes.
+	name            string
+	conf            NodeConf
 	runtimes        map[string]*Runtime
+	sources         map[string]*xkafka.Pubsub
+	queues          map[string]*nodeQueue
 	dispatch        dispatch.Dispatcher
 	resourceManager types.ResourceManager
 	mappers         map[string]mapper.Mapper
+	stages          []*stage
 	revision        int64
 
 	lock   sync.RWMutex
+	qlock  sync.Mutex
 	ctx    context.Context
 	cancel context.CancelFunc
 }
@@ -48,59 +88,145 @@ func NewNode(ctx context.Context, resourceManager types.ResourceManager, dispatc
 		dispatch:        dispatcher,
 		resourceManager: resourceManager,
 		runtimes:        make(map[string]*Runtime),
+		sources:         make(map[string]*xkafka.Pubsub),
+		queues:          make(map[string]*nodeQueue),
 		mappers:         make(map[string]mapper.Mapper),
 	}
 }
 
 func (n *Node) Start(cfg NodeConf) error {
-	log.L().Info("start node...")
+	log.L().Info("start node...", zfield.Name(cfg.NodeName))
 
 	var elapsed util.ElapsedTime
+	if cfg.SnapshotInterval <= 0 {
+		cfg.SnapshotInterval = defaultSnapshotInterval
+	}
+	if cfg.MaxEventHops <= 0 {
+		cfg.MaxEventHops = defaultMaxEventHops
+	}
+	if cfg.HistoryLimit == 0 {
+		cfg.HistoryLimit = defaultHistoryLimit
+	}
+	if cfg.HistoryRetention <= 0 {
+		cfg.HistoryRetention = defaultHistoryRetention
+	}
+	if cfg.OwnerTTL < time.Second {
+		cfg.OwnerTTL = defaultOwnerTTL
+	}
+	if cfg.PersistWindow == 0 {
+		cfg.PersistWindow = defaultPersistWindow
+	}
+
+	var err error
+	if n.stages, err = newStages(cfg.Stages); nil != err {
+		return errors.Wrap(err, "start node")
+	}
+
+	n.conf = cfg
+	if n.name = cfg.NodeName; n.name == "" {
+		n.name, _ = os.Hostname()
+	}
+
 	n.initializeMetadata()
+	deadletter.Initialize(n.resourceManager.Repo(), cfg.DeadLetterSink)
+
+	// sources listed by several nodes, runtime started by the owner only.
 	for index := range cfg.Sources {
-		var err error
-		var sourceIns *xkafka.Pubsub
-		if sourceIns, err = xkafka.NewKafkaPubsub(cfg.Sources[index]); nil != err {
-			return errors.Wrap(err, "create source instance")
-		} else if err = sourceIns.Received(n.ctx, n); nil != err {
-			return errors.Wrap(err, "consume source")
+		if _, err := xkafka.ParseID(cfg.Sources[index]); nil != err {
+			return errors.Wrap(err, "start runtime")
 		}
+		n.campaign(cfg.Sources[index])
+	}
 
-		rid := sourceIns.ID()
-		// create runtime instance.
-		log.L().Info("create runtime instance",
-			zfield.ID(rid), zfield.Source(cfg.Sources[index]))
-
-		entityResouce := EntityResource{FlushHandler: n.FlushEntity, RemoveHandler: n.RemoveEntity}
-		rt := NewRuntime(n.ctx, entityResouce, rid, n.dispatch, n.resourceManager.Repo())
-		for _, mp := range n.mapperSlice() {
-			if mc, has := n.mapper(mp)[rt.ID()]; has {
-				rt.AppendMapper(*mc)
-			}
-		}
-		n.runtimes[rid] = rt
-		placement.Global().Append(placement.Info{ID: sourceIns.ID(), Flag: true})
+	// runtimes of queues assigned to the node.
+	if cfg.NodeName != "" {
+		n.listQueues()
+		go n.watchQueues()
 	}
 
+	go n.expireHistories()
+
 	log.L().Debug("start node completed", zfield.Elapsedms(elapsed.ElapsedMilli()))
 
 	return nil
 }
 
+// Stop drain runtimes within ctx deadline, each runtime pauses consumption,
+// finishes the event in flight, flushes resident entities and commits offsets.
+func (n *Node) Stop(ctx context.Context) error {
+	log.L().Info("stop node...")
+
+	n.lock.RLock()
+	rids := make([]string, 0, len(n.runtimes))
+	for rid := range n.runtimes {
+		rids = append(rids, rid)
+	}
+	n.lock.RUnlock()
+
+	var wg sync.WaitGroup
+	for _, rid := range rids {
+		wg.Add(1)
+		go func(rid string) {
+			defer wg.Done()
+			n.stopRuntime(ctx, rid)
+		}(rid)
+	}
+	wg.Wait()
+
+	// release ownerships.
+	n.cancel()
+	return errors.Wrap(ctx.Err(), "stop node")
+}
+
+// Stats returns entity residency statistics of runtimes.
+func (n *Node) Stats() map[string]ResidencyStats {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	stats := make(map[string]ResidencyStats)
+	for rid, rt := range n.runtimes {
+		stats[rid] = rt.Stats()
+	}
+	return stats
+}
+
+// StageStats returns statistics of event handling stages of runtimes.
+func (n *Node) StageStats() map[string]map[string]StageStats {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	stats := make(map[string]map[string]StageStats)
+	for rid, rt := range n.runtimes {
+		stats[rid] = rt.StageStats()
+	}
+	return stats
+}
+
 func (n *Node) HandleMessage(ctx context.Context, msg *sarama.ConsumerMessage) error {
 	rid := msg.Topic
-	if _, has := n.runtimes[rid]; !has {
+	n.lock.RLock()
+	rt, has := n.runtimes[rid]
+	n.lock.RUnlock()
+	if !has {
 		log.L().Error("runtime instance not exists.", zfield.ID(rid),
 			zap.Any("header", msg.Headers), zfield.Message(string(msg.Value)))
 		return xerrors.ErrRuntimeNotExists
 	}
 
 	// load runtime spec.
-	rt := n.runtimes[rid]
 	rt.DeliveredEvent(context.Background(), msg)
 	return nil
 }
 
+// FlushMessages persist entities written by handled messages before offsets committed.
+func (n *Node) FlushMessages(ctx context.Context, topic string) error {
+	n.lock.RLock()
+	rt, has := n.runtimes[topic]
+	n.lock.RUnlock()
+	if !has {
+		return nil
+	}
+	return errors.Wrap(rt.FlushPending(ctx), "flush messages")
+}
+
 func (n *Node) initializeMetadata() {
 	n.listMetadata()
 	go n.watchMetadata()
@@ -150,9 +276,11 @@ func (n *Node) watchMetadata() {
 				}
 
 				// remove mapper from all runtime.
+				n.lock.RLock()
 				for _, rt := range n.runtimes {
 					rt.RemoveMapper(MCache{ID: mpIns.ID()})
 				}
+				n.lock.RUnlock()
 			case dao.PUT:
 				// parse mapper.
 				var err error
@@ -165,11 +293,13 @@ func (n *Node) watchMetadata() {
 
 				// cache mapper.
 				n.mappers[mpIns.ID()] = mpIns
+				n.lock.RLock()
 				for rtID, mc := range n.mapper(mpIns) {
 					if rt, has := n.runtimes[rtID]; has {
 						rt.AppendMapper(*mc)
 					}
 				}
+				n.lock.RUnlock()
 			}
 		})
 }
@@ -232,16 +362,67 @@ func (n *Node) FlushEntity(ctx context.Context, en Entity) error {
 	return nil
 }
 
+// FlushEntities persist entities and telemetry points in bulk,
+// state storage, search engine and timeseries database written once each.
+func (n *Node) FlushEntities(ctx context.Context, ens []Entity, points []*tseries.TSeriesData) error {
+	log.L().Debug("flush entities", zap.Int("entities", len(ens)), zap.Int("points", len(points)))
+
+	states := make(map[string][]byte, len(ens))
+	indexes := make(map[string][]byte, len(ens))
+	for _, en := range ens {
+		indexData := en.Tiled()
+		if nil != indexData.Error() {
+			log.L().Error("flush entities search engine, build index data",
+				zap.Error(indexData.Error()), zfield.Eid(en.ID()))
+			return errors.Wrap(indexData.Error(), "flush entities into search engine, build index data")
+		}
+
+		states[en.ID()] = en.Raw()
+		indexes[en.ID()] = indexData.Raw()
+	}
+
+	// 1. flush state.
+	if err := n.resourceManager.Repo().PutEntities(ctx, states); nil != err {
+		log.L().Error("flush entities state storage", zap.Error(err), zap.Int("entities", len(ens)))
+		return errors.Wrap(err, "flush entities into state storage")
+	}
+
+	// 2. flush search engine data.
+	if err := n.resourceManager.Search().IndexBulk(ctx, indexes); nil != err {
+		log.L().Error("flush entities search engine", zap.Error(err), zap.Int("entities", len(ens)))
+		return errors.Wrap(err, "flush entities into search engine")
+	}
+
+	// 3. flush timeseries data.
+	if len(points) > 0 {
+		if _, err := n.resourceManager.TSDB().Write(ctx, &tseries.TSeriesRequest{
+			Data:     points,
+			Metadata: map[string]string{},
+		}); nil != err {
+			log.L().Error("flush entities timeseries database", zap.Error(err), zap.Int("points", len(points)))
+		}
+	}
+	return nil
+}
+
 func (n *Node) flushTimeSeries(ctx context.Context, en Entity) (err error) {
+	flushData := timeSeriesData(en)
+	_, err = n.resourceManager.TSDB().Write(ctx, &tseries.TSeriesRequest{
+		Data:     flushData,
+		Metadata: map[string]string{},
+	})
+	return errors.Wrap(err, "write ts db error")
+}
+
+// timeSeriesData returns points of entity telemetry.
+func timeSeriesData(en Entity) []*tseries.TSeriesData {
 	tsData := en.GetProp("telemetry")
 	var flushData []*tseries.TSeriesData
-	log.Info("tsData: ", tsData)
 	var res interface{}
 
-	err = json.Unmarshal(tsData.Raw(), &res)
-	if nil != err {
+	if err := json.Unmarshal(tsData.Raw(), &res); nil != err {
 		log.L().Warn("parse json type", zap.Error(err))
-		return
+		return nil
 	}
 	tss, ok := res.(map[string]interface{})
 	if ok {
@@ -274,11 +455,37 @@ func (n *Node) flushTimeSeries(ctx context.Context, en Entity) (err error) {
 			}
 		}
 	}
-	_, err = n.resourceManager.TSDB().Write(ctx, &tseries.TSeriesRequest{
-		Data:     flushData,
-		Metadata: map[string]string{},
-	})
-	return errors.Wrap(err, "write ts db error")
+	return flushData
+}
+
+// expireHistories delete histories of entities deleted before retention periodically until node stopped,
+// expired by all nodes, histories of entity re-created since listed not deleted.
+func (n *Node) expireHistories() {
+	ticker := time.NewTicker(historyExpireInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-n.ctx.Done():
+			return
+		case <-ticker.C:
+			n.expireHistory(n.ctx, time.Now())
+		}
+	}
+}
+
+func (n *Node) expireHistory(ctx context.Context, now time.Time) {
+	repo := n.resourceManager.Repo()
+	tombstones, err := repo.ListHistoryTombstone(ctx, now.Add(-n.conf.HistoryRetention).UnixMilli())
+	if nil != err {
+		log.L().Error("expire history, list tombstone", zap.Error(err))
+		return
+	}
+
+	for index := range tombstones {
+		if err = repo.ExpireHistory(ctx, &tombstones[index]); nil != err {
+			log.L().Error("expire history", zap.Error(err), zfield.Eid(tombstones[index].EntityID))
+		}
+	}
 }
 
 func (n *Node) RemoveEntity(ctx context.Context, en Entity) error {
@@ -324,5 +531,25 @@ func (n *Node) RemoveEntity(ctx context.Context, en Entity) error {
 			zap.Error(err), zfield.Eid(en.ID()), zfield.Value(string(en.Raw())))
 		return errors.Wrap(err, "remove mapper by entity")
 	}
+
+	// 4. 删除实体的关系.
+	relationships, err := n.resourceManager.Repo().DelRelationshipByEntity(ctx, en.ID())
+	if nil != err {
+		log.L().Error("remove entity, remove relationship by entity",
+			zap.Error(err), zfield.Eid(en.ID()), zfield.Value(string(en.Raw())))
+		return errors.Wrap(err, "remove relationship by entity")
+	}
+
+	for index := range relationships {
+		if _, innerErr := n.resourceManager.Search().DeleteByID(ctx, &v1.DeleteByIDRequest{
+			Id:    relationships[index].ID(),
+			Owner: relationships[index].Owner,
+		}); nil != innerErr {
+			log.L().Warn("remove entity, remove relationship from search engine",
+				zap.Error(innerErr), zfield.Eid(en.ID()), zfield.ID(relationships[index].ID()))
+		}
+	}
+
+	// 实体的变更历史保留至过期, 由 expireHistories 删除.
 	return nil
 }
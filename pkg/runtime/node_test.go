@@ -1,8 +1,14 @@
 package runtime
 
 import (
+	"context"
 	"net/url"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/core/pkg/runtime/mock"
 )
 
 // func TestNode_Start(t *testing.T) {
@@ -28,3 +34,43 @@ func TestParse(t *testing.T) {
 	URL, _ := url.Parse(urlText)
 	t.Log(URL)
 }
+
+func TestNode_StartWithQueues(t *testing.T) {
+	node := NewNode(context.Background(), &ownerResource{repo: mock.NewRepo()}, mock.NewDispatcher())
+	defer node.cancel()
+
+	// queues watched until node stopped.
+	done := make(chan error, 1)
+	go func() { done <- node.Start(NodeConf{NodeName: "node1"}) }()
+	select {
+	case err := <-done:
+		assert.Nil(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("start node blocked by watching queues")
+	}
+}
+
+func TestNode_ExpireHistory(t *testing.T) {
+	repo := mock.NewRepo()
+	node := NewNode(context.Background(), &ownerResource{repo: repo}, mock.NewDispatcher())
+	defer node.cancel()
+	node.conf.HistoryRetention = time.Hour
+
+	now := time.Now()
+	deletedAt := now.Add(-2 * time.Hour).UnixMilli()
+	assert.Nil(t, repo.PutHistories(context.Background(), []*dao.History{
+		{EntityID: "en-1", Version: 2, Timestamp: deletedAt, Deleted: true},
+		{EntityID: "en-2", Version: 2, Timestamp: now.UnixMilli(), Deleted: true},
+		// re-created since deleted.
+		{EntityID: "en-3", Version: 2, Timestamp: deletedAt, Deleted: true},
+		{EntityID: "en-3", Version: 1, Timestamp: now.UnixMilli(), Created: true},
+	}))
+
+	// histories of en-2 kept until retention expired.
+	node.expireHistory(context.Background(), now)
+	assert.Len(t, repo.Tombstones, 1)
+	assert.Contains(t, repo.Tombstones, "en-2")
+
+	node.expireHistory(context.Background(), now.Add(2*time.Hour))
+	assert.Len(t, repo.Tombstones, 0)
+}
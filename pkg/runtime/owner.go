@@ -0,0 +1,100 @@
+package runtime
+
+import (
+	"context"
+	"time"
+
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	xkafka "github.com/tkeel-io/core/pkg/util/kafka"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/zap"
+)
+
+const defaultOwnerTTL = 10 * time.Second
+
+// campaign start runtime of source once the node owns it, until canceled.
+func (n *Node) campaign(source string) context.CancelFunc {
+	ctx, cancel := context.WithCancel(n.ctx)
+	go n.ownRuntime(ctx, source)
+	return cancel
+}
+
+// ownRuntime only the owner of runtime consumes the source, others take over if the lease expired.
+func (n *Node) ownRuntime(ctx context.Context, source string) {
+	rid, err := xkafka.ParseID(source)
+	if nil != err {
+		log.L().Error("own runtime", zap.Error(err), zfield.Source(source))
+		return
+	}
+
+	retry := n.conf.OwnerTTL / 2
+	for ctx.Err() == nil {
+		// lease kept alive until the runtime stopped, writes of stopping runtime compared with the lease.
+		lctx, lcancel := context.WithCancel(n.ctx)
+		if lease, alive, owned := n.acquireOwner(lctx, rid); owned {
+			f := newFence(rid, lease, n.conf.OwnerTTL)
+			if _, err = n.startRuntime(source, f); nil == err {
+				n.keepOwner(ctx, f, alive)
+				n.stopRuntime(context.Background(), rid)
+			} else {
+				log.L().Error("start runtime", zap.Error(err), zfield.ID(rid))
+			}
+		}
+
+		// revoke lease, runtime released.
+		lcancel()
+		select {
+		case <-ctx.Done():
+		case <-time.After(retry):
+		}
+	}
+}
+
+// keepOwner record keepalives of the lease into the fence, until ctx done or the lease lost.
+func (n *Node) keepOwner(ctx context.Context, f *fence, alive <-chan time.Time) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case at, ok := <-alive:
+			if ok {
+				f.KeepAlive(at)
+				continue
+			}
+
+			// stop writing at once, the runtime may be owned by other node already.
+			f.Revoke()
+			log.L().Warn("runtime ownership lost", zfield.ID(f.owner), zfield.Name(n.name), zfield.Lease(f.lease))
+			return
+		}
+	}
+}
+
+// acquireOwner returns true and the lease if the node owns runtime, the channel receives
+// keepalives of the lease, owned until the channel closed.
+func (n *Node) acquireOwner(ctx context.Context, rid string) (int64, <-chan time.Time, bool) {
+	repo := n.resourceManager.Repo()
+	lease, alive, err := repo.GrantLease(ctx, int64(n.conf.OwnerTTL/time.Second))
+	if nil != err {
+		log.L().Error("acquire runtime owner, grant lease", zap.Error(err), zfield.ID(rid))
+		return 0, nil, false
+	}
+
+	owner, err := repo.AcquireOwner(ctx, &dao.Owner{
+		RuntimeID: rid,
+		NodeName:  n.name,
+		Lease:     lease,
+		Timestamp: time.Now().UnixNano() / 1e6,
+	})
+	if nil != err {
+		log.L().Error("acquire runtime owner", zap.Error(err), zfield.ID(rid))
+		return 0, nil, false
+	} else if owner.Lease != lease {
+		log.L().Debug("runtime owned by other node", zfield.ID(rid), zfield.Name(owner.NodeName))
+		return 0, nil, false
+	}
+
+	log.L().Info("runtime owned", zfield.ID(rid), zfield.Name(n.name), zfield.Lease(lease))
+	return lease, alive, true
+}
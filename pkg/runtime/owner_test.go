@@ -0,0 +1,90 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/core/pkg/resource/tseries"
+	"github.com/tkeel-io/core/pkg/runtime/mock"
+)
+
+func TestNode_AcquireOwner(t *testing.T) {
+	repo := mock.NewRepo()
+	node1 := &Node{name: "node1", resourceManager: &ownerResource{repo: repo}, conf: NodeConf{OwnerTTL: time.Second}}
+	node2 := &Node{name: "node2", resourceManager: &ownerResource{repo: repo}, conf: NodeConf{OwnerTTL: time.Second}}
+
+	_, _, owned := node1.acquireOwner(context.Background(), "core0")
+	assert.True(t, owned)
+	_, _, owned = node2.acquireOwner(context.Background(), "core0")
+	assert.False(t, owned)
+
+	// lease expired, taken over.
+	delete(repo.Owners, "core0")
+	_, _, owned = node2.acquireOwner(context.Background(), "core0")
+	assert.True(t, owned)
+	assert.Equal(t, "node2", repo.Owners["core0"].NodeName)
+}
+
+func TestFence_Revoke(t *testing.T) {
+	repo := mock.NewRepo()
+	f := newFence("core0", 1, 0)
+	fenced := &fencedRepository{IRepository: repo, fence: f}
+	flushed := 0
+	erc := f.guardResource(EntityResource{
+		FlushHandler:  func(context.Context, Entity) error { flushed++; return nil },
+		RemoveHandler: func(context.Context, Entity) error { return nil },
+	})
+
+	assert.Nil(t, fenced.PutSnapshot(context.Background(), "core0", []byte("{}")))
+	assert.Nil(t, erc.FlushHandler(context.Background(), nil))
+
+	// ownership lost, writes rejected.
+	f.Revoke()
+	assert.ErrorIs(t, fenced.PutSnapshot(context.Background(), "core0", []byte("[]")), xerrors.ErrRuntimeFenced)
+	assert.ErrorIs(t, erc.FlushHandler(context.Background(), nil), xerrors.ErrRuntimeFenced)
+	assert.Equal(t, 1, flushed)
+	assert.Equal(t, []byte("{}"), repo.Snapshots["core0"])
+
+	// runtime without fence never rejected.
+	var nf *fence
+	assert.Nil(t, nf.Check())
+}
+
+func TestFence_CheckAlive(t *testing.T) {
+	repo := mock.NewRepo()
+	f := newFence("core0", 1, 3*time.Second)
+	fenced := &fencedRepository{IRepository: repo, fence: f}
+	bulk := f.guardBulk(func(context.Context, []Entity, []*tseries.TSeriesData) error { return nil })
+
+	assert.Nil(t, fenced.PutSnapshot(context.Background(), "core0", []byte("{}")))
+	assert.Nil(t, bulk(context.Background(), nil, nil))
+
+	// lease not kept alive, may expire before written.
+	f.KeepAlive(time.Now().Add(-2 * time.Second))
+	assert.ErrorIs(t, fenced.PutSnapshot(context.Background(), "core0", []byte("[]")), xerrors.ErrRuntimeFenced)
+	assert.ErrorIs(t, bulk(context.Background(), nil, nil), xerrors.ErrRuntimeFenced)
+	// etcd writes compared with the lease by etcd.
+	assert.Nil(t, fenced.PutSchedule(context.Background(), &dao.Schedule{ID: "device123", Interval: 1}))
+
+	f.KeepAlive(time.Now())
+	assert.Nil(t, fenced.PutSnapshot(context.Background(), "core0", []byte("[]")))
+	assert.Equal(t, []byte("[]"), repo.Snapshots["core0"])
+}
+
+func TestNode_KeepOwner(t *testing.T) {
+	n := &Node{name: "node1"}
+	f := newFence("core0", 1, time.Second)
+	alive := make(chan time.Time, 1)
+	at := time.Now().Add(time.Minute)
+	alive <- at
+	close(alive)
+
+	// ownership lost once keepalive closed.
+	n.keepOwner(context.Background(), f, alive)
+	assert.Equal(t, at.UnixNano(), f.alive.Load())
+	assert.True(t, f.Revoked())
+}
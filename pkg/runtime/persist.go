@@ -0,0 +1,231 @@
+package runtime
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/core/pkg/resource/tseries"
+	"github.com/tkeel-io/core/pkg/util"
+	batchqueue "github.com/tkeel-io/core/pkg/util/batch_queue"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/zap"
+)
+
+const defaultPersistWindow = 100 * time.Millisecond
+
+type BulkResourceFunc func(context.Context, []Entity, []*tseries.TSeriesData) error
+
+type HistoryResourceFunc func(context.Context, []*dao.History, []*dao.EntitySnapshot) error
+
+// writeBehind coalesce writes of the same entity within window, persist them in bulk,
+// telemetry points of every write kept.
+type writeBehind struct {
+	id      string
+	sink    batchqueue.BatchSink
+	flushFn BulkResourceFunc
+	// onFlushed called with write versions of entities persisted, keyed by entity id.
+	onFlushed func(flushed map[string]int64)
+	// pending entities written and not persisted.
+	pending map[string]pendingWrite
+	// points telemetry points written and not persisted.
+	points []*tseries.TSeriesData
+	// historyFn persist histories and entity snapshots, after entities of the batch persisted.
+	historyFn HistoryResourceFunc
+	// histories and snapshots of entities written and not persisted.
+	histories []*dao.History
+	snapshots []*dao.EntitySnapshot
+	// err last error of persisting, reported once flushed.
+	err error
+
+	lock   sync.Mutex
+	flock  sync.Mutex
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// pendingWrite entity written behind, with write version of resident entity then.
+type pendingWrite struct {
+	entity Entity
+	writes int64
+}
+
+func newWriteBehind(ctx context.Context, id string, window time.Duration, flushFn BulkResourceFunc, historyFn HistoryResourceFunc, onFlushed func(map[string]int64)) (*writeBehind, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	w := &writeBehind{
+		id:        id,
+		ctx:       ctx,
+		cancel:    cancel,
+		flushFn:   flushFn,
+		historyFn: historyFn,
+		onFlushed: onFlushed,
+		pending:   make(map[string]pendingWrite),
+	}
+
+	var err error
+	if w.sink, err = batchqueue.NewBatchSink(ctx, &batchqueue.Config{
+		Name:                  id,
+		DoSinkFn:              w.persist,
+		BatchingMaxFlushDelay: window,
+	}); nil != err {
+		cancel()
+		return nil, errors.Wrap(err, "create write behind sink")
+	}
+	return w, nil
+}
+
+// Put write entity behind, the latest state persisted if written repeatedly within window,
+// writes is the write version of resident entity, reported once persisted.
+func (w *writeBehind) Put(ctx context.Context, en Entity, writes int64) error {
+	points := timeSeriesData(en)
+	w.lock.Lock()
+	_, queued := w.pending[en.ID()]
+	w.pending[en.ID()] = pendingWrite{entity: en.Copy(), writes: writes}
+	w.points = append(w.points, points...)
+	w.lock.Unlock()
+
+	if queued {
+		return nil
+	}
+	return errors.Wrap(w.sink.Send(ctx, en.ID()), "write behind")
+}
+
+// PutHistory write history of entity behind, persisted with snapshot of entity if not nil.
+func (w *writeBehind) PutHistory(ctx context.Context, h *dao.History, snapshot *dao.EntitySnapshot) error {
+	w.lock.Lock()
+	w.histories = append(w.histories, h)
+	if nil != snapshot {
+		w.snapshots = append(w.snapshots, snapshot)
+	}
+	w.lock.Unlock()
+	return errors.Wrap(w.sink.Send(ctx, h.EntityID), "write history behind")
+}
+
+// Get returns entity written and not persisted, which is newer than the state storage.
+func (w *writeBehind) Get(id string) (Entity, bool) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if pw, has := w.pending[id]; has {
+		return pw.entity.Copy(), true
+	}
+	return nil, false
+}
+
+// Flush wait entities written before persisted, returns error if any persisting failed.
+func (w *writeBehind) Flush(ctx context.Context) error {
+	if err := w.sink.Flush(ctx); nil != err {
+		return errors.Wrap(err, "flush write behind")
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	err := w.err
+	w.err = nil
+	return errors.Wrap(err, "flush write behind")
+}
+
+// Close persist entities written, stop the sink.
+func (w *writeBehind) Close() {
+	w.sink.Close()
+	w.cancel()
+}
+
+func (w *writeBehind) persist(msgs []interface{}) error {
+	// batches persisted in order, latest state never overwritten by the former.
+	w.flock.Lock()
+	defer w.flock.Unlock()
+
+	w.lock.Lock()
+	writes := make([]pendingWrite, 0, len(msgs))
+	entities := make([]Entity, 0, len(msgs))
+	for _, msg := range msgs {
+		id, _ := msg.(string)
+		if pw, has := w.pending[id]; has {
+			writes = append(writes, pw)
+			entities = append(entities, pw.entity)
+			delete(w.pending, id)
+		}
+	}
+	points, histories, snapshots := w.points, w.histories, w.snapshots
+	w.points, w.histories, w.snapshots = nil, nil, nil
+	w.lock.Unlock()
+
+	if len(entities) == 0 && len(points) == 0 && len(histories) == 0 {
+		return nil
+	}
+
+	elapsed := util.NewElapsed()
+	if err := w.flushFn(w.ctx, entities, points); nil != err {
+		log.L().Error("persist entities", zap.Error(err),
+			zfield.ID(w.id), zap.Int("entities", len(entities)))
+		w.retry(writes, points, err)
+		w.retryHistories(histories, snapshots, err)
+		return errors.Wrap(err, "persist entities")
+	}
+
+	if len(histories) > 0 {
+		if err := w.historyFn(w.ctx, histories, snapshots); nil != err {
+			log.L().Error("persist histories", zap.Error(err),
+				zfield.ID(w.id), zap.Int("histories", len(histories)))
+			w.retryHistories(histories, snapshots, err)
+		}
+	}
+
+	// entities written again since keep dirty, write versions of them not matched.
+	flushed := make(map[string]int64, len(writes))
+	for _, pw := range writes {
+		flushed[pw.entity.ID()] = pw.writes
+	}
+
+	w.onFlushed(flushed)
+	log.L().Debug("persist entities", zfield.ID(w.id),
+		zap.Int("entities", len(entities)), zfield.Elapsedms(elapsed.ElapsedMilli()))
+	return nil
+}
+
+// retryHistories requeue histories failed persisting, in order before histories written since.
+func (w *writeBehind) retryHistories(histories []*dao.History, snapshots []*dao.EntitySnapshot, err error) {
+	if len(histories) == 0 {
+		return
+	}
+
+	w.lock.Lock()
+	w.err = err
+	w.histories = append(histories, w.histories...)
+	w.snapshots = append(snapshots, w.snapshots...)
+	w.lock.Unlock()
+
+	// sent after the batch completed, pending batches of sink are bounded.
+	go func() {
+		if innerErr := w.sink.Send(w.ctx, histories[0].EntityID); nil != innerErr {
+			log.L().Error("requeue histories", zap.Error(innerErr), zfield.ID(w.id))
+		}
+	}()
+}
+
+// retry requeue entities failed persisting, unless written again since.
+func (w *writeBehind) retry(writes []pendingWrite, points []*tseries.TSeriesData, err error) {
+	var requeued []string
+	w.lock.Lock()
+	w.err = err
+	w.points = append(points, w.points...)
+	for _, pw := range writes {
+		if _, has := w.pending[pw.entity.ID()]; !has {
+			w.pending[pw.entity.ID()] = pw
+			requeued = append(requeued, pw.entity.ID())
+		}
+	}
+	w.lock.Unlock()
+
+	// sent after the batch completed, pending batches of sink are bounded.
+	go func() {
+		for _, id := range requeued {
+			if innerErr := w.sink.Send(w.ctx, id); nil != innerErr {
+				log.L().Error("requeue entity", zap.Error(innerErr), zfield.ID(w.id), zfield.Eid(id))
+			}
+		}
+	}()
+}
@@ -0,0 +1,104 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core/pkg/resource/tseries"
+	"github.com/tkeel-io/core/pkg/runtime/mock"
+)
+
+func TestWriteBehind(t *testing.T) {
+	var lock sync.Mutex
+	var batches [][]Entity
+	flushed := make(map[string]int64)
+	flushFn := func(_ context.Context, ens []Entity, _ []*tseries.TSeriesData) error {
+		lock.Lock()
+		defer lock.Unlock()
+		batches = append(batches, ens)
+		return nil
+	}
+	onFlushed := func(writes map[string]int64) {
+		lock.Lock()
+		defer lock.Unlock()
+		for id, version := range writes {
+			flushed[id] = version
+		}
+	}
+
+	w, err := newWriteBehind(context.Background(), "rt-1", time.Hour, flushFn, nil, onFlushed)
+	assert.Nil(t, err)
+	defer w.Close()
+
+	// writes of the same entity coalesced.
+	assert.Nil(t, w.Put(context.Background(), DefaultEntity("en-1"), 1))
+	assert.Nil(t, w.Put(context.Background(), DefaultEntity("en-2"), 1))
+	assert.Nil(t, w.Put(context.Background(), DefaultEntity("en-1"), 2))
+	_, ok := w.Get("en-1")
+	assert.True(t, ok)
+	assert.Nil(t, w.Flush(context.Background()))
+	_, ok = w.Get("en-1")
+	assert.False(t, ok)
+
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Len(t, batches, 1)
+	assert.Len(t, batches[0], 2)
+	assert.Equal(t, map[string]int64{"en-1": 2, "en-2": 1}, flushed)
+}
+
+func TestWriteBehind_Error(t *testing.T) {
+	var fail = true
+	var lock sync.Mutex
+	flushFn := func(context.Context, []Entity, []*tseries.TSeriesData) error {
+		lock.Lock()
+		defer lock.Unlock()
+		if fail {
+			return errors.New("store unavailable")
+		}
+		return nil
+	}
+
+	w, err := newWriteBehind(context.Background(), "rt-1", time.Hour, flushFn, nil, func(map[string]int64) {})
+	assert.Nil(t, err)
+	defer w.Close()
+
+	assert.Nil(t, w.Put(context.Background(), DefaultEntity("en-1"), 1))
+	assert.NotNil(t, w.Flush(context.Background()))
+
+	// entity requeued, persisted once store recovered.
+	lock.Lock()
+	fail = false
+	lock.Unlock()
+	assert.Eventually(t, func() bool {
+		if nil != w.Flush(context.Background()) {
+			return false
+		}
+		w.lock.Lock()
+		defer w.lock.Unlock()
+		return len(w.pending) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestRuntime_LoadEntityWrittenBehind(t *testing.T) {
+	rt, _ := newTestRuntime(mock.NewDispatcher(), 0)
+	flushFn := func(context.Context, []Entity, []*tseries.TSeriesData) error { return nil }
+	w, err := newWriteBehind(context.Background(), "core-1", time.Hour, flushFn, rt.flushHistories, rt.markFlushed)
+	assert.Nil(t, err)
+	defer w.Close()
+	rt.persister = w
+
+	// evicted entity not persisted yet loaded from write behind, rather than state storage.
+	en, err := NewEntity("en-1", []byte(`{"version": 3, "properties": {"temp": 20}}`))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Put(context.Background(), en, 1))
+
+	loaded, err := rt.loadEntity("en-1", true)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), loaded.Version())
+	assert.True(t, rt.existEntity(context.Background(), "en-1"))
+}
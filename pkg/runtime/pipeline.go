@@ -0,0 +1,307 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/core/pkg/util"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/zap"
+)
+
+// built-in stages of entity event handling.
+const (
+	StageRawData    = "raw_data"
+	StageTentacle   = "tentacle"
+	StageComputed   = "computed"
+	StagePersistent = "persistent"
+	StageHistory    = "history"
+	StageTemplate   = "template"
+	StageSchedule   = "schedule"
+	StageDerivation = "derivation"
+	StagePropagate  = "propagate"
+)
+
+// phases of entity event handling.
+const (
+	// PhasePre stages run before patches applied.
+	PhasePre = "pre"
+	// PhasePost stages run after patches applied.
+	PhasePost = "post"
+)
+
+var (
+	registeredHandlers = make(map[string]HandlerGenerator)
+	hlock              sync.RWMutex
+)
+
+// HandlerGenerator create handler of custom stage with properties configured,
+// handler shared by runtimes of node, must be safe for concurrent use.
+type HandlerGenerator func(map[string]interface{}) (Handler, error)
+
+// RegisterHandler register handler of custom stages, called in init of plugin packages.
+func RegisterHandler(name string, generator HandlerGenerator) {
+	hlock.Lock()
+	defer hlock.Unlock()
+	registeredHandlers[name] = generator
+}
+
+// StageConf custom stage of entity event handling.
+type StageConf struct {
+	// Name unique name of stage.
+	Name string
+	// Handler name of handler registered.
+	Handler string
+	// Phase pre or post, default post.
+	Phase string
+	// Order stages of phase run in ascending order, built-in stages first if ordered equally,
+	// pre: raw_data 100, post: tentacle 200, computed 300, persistent 400, history 500,
+	// template 600, schedule 700, derivation 800, propagate 900.
+	Order int
+	// EntityTypes types of entities handled, all if empty.
+	EntityTypes []string
+	// Timeout deadline of each handling, no deadline if zero.
+	Timeout time.Duration
+	// Optional errors of optional stage logged and the feed before stage kept, errors of required
+	// pre stage fail the event, errors of required post stage logged and custom stages after it skipped.
+	Optional bool
+	// Properties passed to handler generator.
+	Properties map[string]interface{}
+}
+
+// StageStats statistics of stage handling.
+type StageStats struct {
+	Calls     int64 `json:"calls"`
+	Errors    int64 `json:"errors"`
+	Elapsedms int64 `json:"elapsedms"`
+}
+
+type stage struct {
+	name     string
+	phase    string
+	order    int
+	types    map[string]bool
+	timeout  time.Duration
+	optional bool
+	builtin  bool
+	handler  Handler
+
+	calls   int64
+	errors  int64
+	elapsed int64
+}
+
+// newStages create custom stages with handlers registered.
+func newStages(confs []StageConf) ([]*stage, error) {
+	hlock.RLock()
+	defer hlock.RUnlock()
+
+	names := make(map[string]bool)
+	for _, name := range entityStages {
+		names[name] = true
+	}
+
+	stages := make([]*stage, 0, len(confs))
+	for _, conf := range confs {
+		if names[conf.Name] {
+			return nil, errors.Errorf("stage %s duplicated", conf.Name)
+		}
+		names[conf.Name] = true
+
+		if conf.Phase == "" {
+			conf.Phase = PhasePost
+		} else if conf.Phase != PhasePre && conf.Phase != PhasePost {
+			return nil, errors.Errorf("stage %s, invalid phase %s", conf.Name, conf.Phase)
+		}
+
+		generator, has := registeredHandlers[conf.Handler]
+		if !has {
+			return nil, errors.Errorf("stage %s, handler %s not registered", conf.Name, conf.Handler)
+		}
+		handler, err := generator(conf.Properties)
+		if nil != err {
+			return nil, errors.Wrapf(err, "stage %s, create handler %s", conf.Name, conf.Handler)
+		}
+
+		var types map[string]bool
+		if len(conf.EntityTypes) > 0 {
+			types = make(map[string]bool)
+			for _, typ := range conf.EntityTypes {
+				types[typ] = true
+			}
+		}
+
+		stages = append(stages, &stage{
+			name:     conf.Name,
+			phase:    conf.Phase,
+			order:    conf.Order,
+			types:    types,
+			timeout:  conf.Timeout,
+			optional: conf.Optional,
+			handler:  handler,
+		})
+	}
+	return stages, nil
+}
+
+// Handle run handler of stage on copy of feed, recovers panics and isolates errors of optional stage,
+// errors of required custom post stages reported as failed side effects, the patches applied already.
+func (s *stage) Handle(ctx context.Context, feed *Feed) (out *Feed) {
+	if nil != feed.Err || (nil != feed.stageErr && !s.builtin) {
+		return feed
+	}
+
+	elapsed := util.NewElapsed()
+	saved := feed
+	if !s.builtin {
+		// restored if failed, handled feed shares nothing with saved.
+		feed = saved.clone()
+	}
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+
+	defer func() {
+		if r := recover(); nil != r {
+			log.L().Error("handle stage, recovered", zap.Any("panic", r),
+				zap.String("stage", s.name), zfield.Eid(saved.EntityID))
+			out = saved.clone()
+			out.Err = fmt.Errorf("stage %s panic: %v", s.name, r)
+		} else if nil == out {
+			out = saved.clone()
+			out.Err = fmt.Errorf("stage %s returns nil feed", s.name)
+		}
+
+		atomic.AddInt64(&s.calls, 1)
+		atomic.AddInt64(&s.elapsed, elapsed.ElapsedMilli())
+		if nil == out.Err {
+			return
+		}
+
+		// errors of required pre stages and built-in stages handled as event failed.
+		atomic.AddInt64(&s.errors, 1)
+		if s.optional {
+			log.L().Warn("handle optional stage", zap.Error(out.Err),
+				zap.String("stage", s.name), zfield.Eid(saved.EntityID))
+			out = saved
+		} else if s.phase == PhasePost && !s.builtin {
+			log.L().Error("handle post stage, side effect failed", zap.Error(out.Err),
+				zap.String("stage", s.name), zfield.Eid(saved.EntityID))
+			saved.stageErr = out.Err
+			out = saved
+		}
+	}()
+
+	return s.handler.Handle(ctx, feed)
+}
+
+func (s *stage) stats() StageStats {
+	return StageStats{
+		Calls:     atomic.LoadInt64(&s.calls),
+		Errors:    atomic.LoadInt64(&s.errors),
+		Elapsedms: atomic.LoadInt64(&s.elapsed),
+	}
+}
+
+// selected returns if stage handles entities of type.
+func (s *stage) selected(phase, entityType string) bool {
+	return s.phase == phase && (nil == s.types || s.types[entityType])
+}
+
+// entityStages built-in stages of entity event, pre stages first.
+var entityStages = []string{
+	StageRawData,
+	StageTentacle,
+	StageComputed,
+	StagePersistent,
+	StageHistory,
+	StageTemplate,
+	StageSchedule,
+	StageDerivation,
+	StagePropagate,
+}
+
+// systemStages built-in stages of system event, all post.
+var systemStages = []string{
+	StagePersistent,
+	StageHistory,
+	StageSchedule,
+	StageDerivation,
+}
+
+type pipeline struct {
+	stages []*stage
+	named  map[string]*stage
+}
+
+// newPipeline combine built-in stages of runtime and custom stages, stats of pipeline kept per runtime.
+func newPipeline(builtins map[string]Handler, customs []*stage) *pipeline {
+	p := &pipeline{named: make(map[string]*stage)}
+	for index, name := range entityStages {
+		s := &stage{name: name, phase: PhasePost, order: (index + 1) * 100, builtin: true, handler: builtins[name]}
+		if name == StageRawData {
+			s.phase, s.order = PhasePre, 100
+		}
+		p.stages = append(p.stages, s)
+		p.named[name] = s
+	}
+
+	for _, custom := range customs {
+		s := &stage{
+			name:     custom.name,
+			phase:    custom.phase,
+			order:    custom.order,
+			types:    custom.types,
+			timeout:  custom.timeout,
+			optional: custom.optional,
+			handler:  custom.handler,
+		}
+		p.stages = append(p.stages, s)
+		p.named[s.name] = s
+	}
+
+	// built-in stages first if ordered equally.
+	sort.SliceStable(p.stages, func(i, j int) bool {
+		return p.stages[i].order < p.stages[j].order
+	})
+	return p
+}
+
+// Handlers returns stages of phase which handle entities of type.
+func (p *pipeline) Handlers(phase, entityType string) []Handler {
+	var handlers []Handler
+	for _, s := range p.stages {
+		if s.selected(phase, entityType) {
+			handlers = append(handlers, s)
+		}
+	}
+	return handlers
+}
+
+// Named returns stages named.
+func (p *pipeline) Named(names ...string) []Handler {
+	handlers := make([]Handler, 0, len(names))
+	for _, name := range names {
+		if s, has := p.named[name]; has {
+			handlers = append(handlers, s)
+		}
+	}
+	return handlers
+}
+
+// Stats returns statistics of stages.
+func (p *pipeline) Stats() map[string]StageStats {
+	stats := make(map[string]StageStats, len(p.stages))
+	for _, s := range p.stages {
+		stats[s.name] = s.stats()
+	}
+	return stats
+}
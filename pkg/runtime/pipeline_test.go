@@ -0,0 +1,152 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/tdtl"
+)
+
+func recordHandler(name string, names *[]string) Handler {
+	return &handlerImpl{fn: func(_ context.Context, feed *Feed) *Feed {
+		*names = append(*names, name)
+		return feed
+	}}
+}
+
+func TestPipeline(t *testing.T) {
+	var names []string
+	builtins := map[string]Handler{}
+	for _, name := range entityStages {
+		builtins[name] = recordHandler(name, &names)
+	}
+
+	RegisterHandler("test-record", func(props map[string]interface{}) (Handler, error) {
+		name, _ := props["name"].(string)
+		return recordHandler(name, &names), nil
+	})
+
+	stages, err := newStages([]StageConf{
+		{Name: "enrich", Handler: "test-record", Phase: PhasePre, Order: 50,
+			Properties: map[string]interface{}{"name": "enrich"}},
+		{Name: "validate", Handler: "test-record", Order: 300, EntityTypes: []string{"device"},
+			Properties: map[string]interface{}{"name": "validate"}},
+	})
+	assert.Nil(t, err)
+
+	p := newPipeline(builtins, stages)
+	feed := &Feed{EntityID: "en-1"}
+	for _, h := range p.Handlers(PhasePre, "device") {
+		feed = h.Handle(context.Background(), feed)
+	}
+	for _, h := range p.Handlers(PhasePost, "device") {
+		feed = h.Handle(context.Background(), feed)
+	}
+	assert.Equal(t, []string{"enrich", StageRawData, StageTentacle, StageComputed, "validate",
+		StagePersistent, StageHistory, StageTemplate, StageSchedule, StageDerivation, StagePropagate}, names)
+
+	// stages selected by entity type.
+	assert.Len(t, p.Handlers(PhasePost, "gateway"), len(entityStages)-1)
+	assert.Len(t, p.Named(systemStages...), len(systemStages))
+	assert.Equal(t, int64(1), p.Stats()["validate"].Calls)
+}
+
+func TestPipeline_Stages(t *testing.T) {
+	_, err := newStages([]StageConf{{Name: StagePersistent, Handler: "test-record"}})
+	assert.NotNil(t, err)
+
+	_, err = newStages([]StageConf{{Name: "enrich", Handler: "unregistered"}})
+	assert.NotNil(t, err)
+
+	RegisterHandler("test-noop", func(map[string]interface{}) (Handler, error) {
+		return &handlerImpl{fn: func(_ context.Context, feed *Feed) *Feed { return feed }}, nil
+	})
+	_, err = newStages([]StageConf{{Name: "enrich", Handler: "test-noop", Phase: "middle"}})
+	assert.NotNil(t, err)
+}
+
+func TestStage_Isolation(t *testing.T) {
+	failed := &handlerImpl{fn: func(_ context.Context, feed *Feed) *Feed {
+		feed.Err = errors.New("registry unavailable")
+		return feed
+	}}
+	panicked := &handlerImpl{fn: func(context.Context, *Feed) *Feed {
+		panic("enrich")
+	}}
+
+	// errors of optional stage skipped.
+	s := &stage{name: "validate", optional: true, handler: failed}
+	feed := s.Handle(context.Background(), &Feed{EntityID: "en-1"})
+	assert.Nil(t, feed.Err)
+	assert.Equal(t, StageStats{Calls: 1, Errors: 1}, s.stats())
+
+	s = &stage{name: "validate", handler: failed}
+	feed = s.Handle(context.Background(), &Feed{EntityID: "en-1"})
+	assert.NotNil(t, feed.Err)
+
+	// panics recovered.
+	s = &stage{name: "enrich", handler: panicked}
+	feed = s.Handle(context.Background(), &Feed{EntityID: "en-1"})
+	assert.NotNil(t, feed.Err)
+	assert.Equal(t, "en-1", feed.EntityID)
+
+	s = &stage{name: "enrich", optional: true, handler: panicked}
+	feed = s.Handle(context.Background(), &Feed{EntityID: "en-1"})
+	assert.Nil(t, feed.Err)
+
+	// changes restored if optional stage failed.
+	changes := []Patch{{Path: "properties.temp", Value: tdtl.New(`{"value":20}`), Op: xjson.OpReplace}}
+	s = &stage{name: "enrich", optional: true, handler: &handlerImpl{fn: func(_ context.Context, feed *Feed) *Feed {
+		feed.Changes[0].Path = "properties.hum"
+		feed.Changes[0].Value.Set("value", tdtl.New("40"))
+		feed.Changes = append(feed.Changes, Patch{Path: "properties.hum"})
+		panic("enrich")
+	}}}
+	feed = s.Handle(context.Background(), &Feed{EntityID: "en-1", Changes: changes})
+	assert.Nil(t, feed.Err)
+	assert.Len(t, feed.Changes, 1)
+	assert.Equal(t, "properties.temp", feed.Changes[0].Path)
+	assert.Equal(t, "20", feed.Changes[0].Value.Get("value").String())
+}
+
+func TestPipeline_PostStageFailed(t *testing.T) {
+	var names []string
+	builtins := map[string]Handler{}
+	for _, name := range entityStages {
+		builtins[name] = recordHandler(name, &names)
+	}
+
+	RegisterHandler("test-record", func(props map[string]interface{}) (Handler, error) {
+		name, _ := props["name"].(string)
+		return recordHandler(name, &names), nil
+	})
+	RegisterHandler("test-failed", func(map[string]interface{}) (Handler, error) {
+		return &handlerImpl{fn: func(_ context.Context, feed *Feed) *Feed {
+			feed.Err = errors.New("registry unavailable")
+			return feed
+		}}, nil
+	})
+	stages, err := newStages([]StageConf{
+		{Name: "validate", Handler: "test-failed", Order: 300},
+		{Name: "audit", Handler: "test-record", Order: 450,
+			Properties: map[string]interface{}{"name": "audit"}},
+	})
+	assert.Nil(t, err)
+
+	// built-in stages handle patches applied, custom stages after failed skipped,
+	// the event not failed by side effects.
+	p := newPipeline(builtins, stages)
+	execer := &Execer{
+		execFunc:  recordHandler("apply", &names),
+		postFuncs: p.Handlers(PhasePost, "device"),
+	}
+	feed := execer.Exec(context.Background(), &Feed{EntityID: "en-1"})
+	assert.Nil(t, feed.Err)
+	assert.Equal(t, []string{"apply", StageTentacle, StageComputed, StagePersistent, StageHistory,
+		StageTemplate, StageSchedule, StageDerivation, StagePropagate}, names)
+	assert.Equal(t, int64(1), p.Stats()["validate"].Errors)
+	assert.Equal(t, int64(0), p.Stats()["audit"].Calls)
+}
@@ -0,0 +1,330 @@
+package runtime
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/core/pkg/util"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+	"go.uber.org/zap"
+)
+
+const (
+	// report progress of propagate job every batch of entities.
+	propagateReportBatch = 100
+	// interval of flushing progress of jobs reported by runtime.
+	jobReportInterval = 2 * time.Second
+	// timeout of flushing progress of jobs when runtime stopped.
+	jobReportTimeout = 5 * time.Second
+)
+
+// jobProgress entities of job applied or failed on the runtime since last flushed.
+type jobProgress struct {
+	applied int64
+	failed  int64
+}
+
+// handleDerivation maintain reverse index from templates to the entity.
+func (r *Runtime) handleDerivation(ctx context.Context, feed *Feed) *Feed {
+	if nil != feed.Err {
+		return feed
+	}
+
+	put := false
+	switch ev := feed.Event.(type) {
+	case v1.SystemEvent:
+		switch v1.SystemOp(ev.Action().GetOperator()) {
+		case v1.OpCreate:
+			put = true
+		case v1.OpDelete:
+			for _, tid := range templatesOf(tdtl.New(feed.State)) {
+				r.delDerivation(ctx, tid, feed.EntityID)
+			}
+		}
+	default:
+		for _, change := range feed.Changes {
+			if FieldTemplate == change.Path || FieldTemplates == change.Path {
+				put = true
+				break
+			}
+		}
+	}
+
+	// stale derivations removed when propagating.
+	if put {
+		for _, tid := range templatesOf(tdtl.New(feed.State)) {
+			dv := &dao.Derivation{TemplateID: tid, EntityID: feed.EntityID}
+			if err := r.repository.PutDerivation(ctx, dv); nil != err {
+				log.L().Error("put derivation", zap.Error(err),
+					zfield.Eid(feed.EntityID), zfield.Template(tid))
+			}
+		}
+	}
+
+	return feed
+}
+
+func (r *Runtime) delDerivation(ctx context.Context, tid, eid string) {
+	if err := r.repository.DelDerivation(ctx,
+		&dao.Derivation{TemplateID: tid, EntityID: eid}); nil != err {
+		log.L().Error("delete derivation", zap.Error(err), zfield.Eid(eid), zfield.Template(tid))
+	}
+}
+
+// handlePropagate propagate scheme changes of template to derived entities.
+func (r *Runtime) handlePropagate(ctx context.Context, feed *Feed) *Feed {
+	if nil != feed.Err {
+		return feed
+	}
+
+	for _, change := range feed.Changes {
+		if FieldScheme == change.Path ||
+			strings.HasPrefix(change.Path, FieldScheme+".") {
+			// derived entities may be thousands, propagate asynchronously.
+			r.schedulePropagate(feed.EntityID)
+			break
+		}
+	}
+	return feed
+}
+
+// schedulePropagate queue template to propagate, merged if queued already,
+// the propagation reads the latest derivations and scheme when started.
+func (r *Runtime) schedulePropagate(templateID string) {
+	r.plock.Lock()
+	if _, has := r.propagations[templateID]; !has {
+		r.propagations[templateID] = struct{}{}
+		r.propagateQueue = append(r.propagateQueue, templateID)
+	}
+	r.plock.Unlock()
+
+	select {
+	case r.propagateCh <- struct{}{}:
+	default:
+	}
+}
+
+// Propagate propagate templates queued one by one, and flush progress of jobs reported
+// periodically, until runtime stopped.
+func (r *Runtime) Propagate() {
+	ticker := time.NewTicker(jobReportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.ctx.Done():
+			// runtime context canceled, progress reported flushed within timeout.
+			ctx, cancel := context.WithTimeout(context.Background(), jobReportTimeout)
+			r.flushJobs(ctx)
+			cancel()
+			return
+		case <-ticker.C:
+			r.flushJobs(r.ctx)
+		case <-r.propagateCh:
+			for templateID, ok := r.nextPropagation(); ok; templateID, ok = r.nextPropagation() {
+				r.propagate(r.ctx, templateID)
+			}
+		}
+	}
+}
+
+func (r *Runtime) nextPropagation() (string, bool) {
+	r.plock.Lock()
+	defer r.plock.Unlock()
+	if len(r.propagateQueue) == 0 {
+		return "", false
+	}
+
+	templateID := r.propagateQueue[0]
+	r.propagateQueue = r.propagateQueue[1:]
+	delete(r.propagations, templateID)
+	return templateID, true
+}
+
+// propagate dispatch events which make derived entities resolve scheme again.
+func (r *Runtime) propagate(ctx context.Context, templateID string) *dao.Job {
+	derivations, err := r.repository.ListDerivation(ctx, templateID)
+	if nil != err {
+		log.L().Error("list derivation", zap.Error(err), zfield.Template(templateID))
+		return nil
+	} else if len(derivations) == 0 {
+		return nil
+	}
+
+	job := &dao.Job{
+		ID:        util.UUID("job"),
+		Type:      dao.JobTypeTemplatePropagate,
+		Target:    templateID,
+		Status:    dao.JobStatusRunning,
+		Total:     int64(len(derivations)),
+		StartTime: time.Now().UnixNano(),
+	}
+
+	log.L().Info("propagate template scheme", zfield.ID(job.ID),
+		zfield.Template(templateID), zap.Int64("total", job.Total))
+	r.putJob(ctx, job)
+
+	// job updated by runtimes applying concurrently, report increments.
+	var dispatched, failed int64
+	report := func() {
+		if updated := r.updateJob(ctx, job.ID, func(j *dao.Job) {
+			j.Dispatched += dispatched
+			j.Failed += failed
+			finishJob(j)
+		}); nil != updated {
+			job = updated
+		}
+		dispatched, failed = 0, 0
+	}
+
+	for index, dv := range derivations {
+		ev := &v1.ProtoEvent{
+			Id:        util.IG().EvID(),
+			Timestamp: time.Now().UnixNano(),
+			Metadata: map[string]string{
+				v1.MetaType:       string(v1.ETEntity),
+				v1.MetaEntityID:   dv.EntityID,
+				v1.MetaSender:     templateID,
+				v1.MetaTemplateID: templateID,
+				v1.MetaJobID:      job.ID},
+			Data: &v1.ProtoEvent_Patches{
+				Patches: &v1.PatchDatas{}}}
+
+		if err = r.dispatcher.Dispatch(ctx, ev); nil != err {
+			log.L().Error("propagate template scheme", zap.Error(err),
+				zfield.ID(job.ID), zfield.Eid(dv.EntityID), zfield.Template(templateID))
+			failed++
+			r.putJobFailure(ctx, job.ID, dv.EntityID, err)
+		} else {
+			dispatched++
+		}
+
+		if (index+1)%propagateReportBatch == 0 {
+			report()
+		}
+	}
+
+	report()
+	return job
+}
+
+// finishJob complete job once all entities applied or failed.
+func finishJob(job *dao.Job) {
+	if job.Status != dao.JobStatusRunning || job.Applied+job.Failed < job.Total {
+		return
+	}
+
+	job.Status = dao.JobStatusSucceeded
+	if job.Failed > 0 {
+		job.Status = dao.JobStatusFailed
+	}
+	job.EndTime = time.Now().UnixNano()
+}
+
+// onTemplatePropagated resolve scheme of derived entity again.
+func (r *Runtime) onTemplatePropagated(ctx context.Context, feed *Feed, tid string) error {
+	derived := false
+	for _, id := range templatesOf(tdtl.New(feed.State)) {
+		derived = derived || id == tid
+	}
+
+	if !derived {
+		log.L().Info("remove stale derivation", zfield.Eid(feed.EntityID), zfield.Template(tid))
+		r.delDerivation(ctx, tid, feed.EntityID)
+		return nil
+	}
+
+	// applied once the scheme inherited dispatched handled.
+	dispatched, err := r.onTemplateChanged(ctx, feed.EntityID, feed.Event.Attr(v1.MetaJobID))
+	feed.jobPending = dispatched
+	return err
+}
+
+// reportJob report result of event triggered by job, unless reported by the event dispatched then,
+// progress aggregated and flushed periodically, the job updated by runtimes concurrently.
+func (r *Runtime) reportJob(ctx context.Context, ev v1.Event, feed *Feed) {
+	jobID := ev.Attr(v1.MetaJobID)
+	if jobID == "" || (nil == feed.Err && feed.jobPending) {
+		return
+	}
+
+	if nil != feed.Err {
+		r.putJobFailure(ctx, jobID, feed.EntityID, feed.Err)
+	}
+
+	r.jlock.Lock()
+	progress, has := r.jobReports[jobID]
+	if !has {
+		progress = &jobProgress{}
+		r.jobReports[jobID] = progress
+	}
+	if nil != feed.Err {
+		progress.failed++
+	} else {
+		progress.applied++
+	}
+	r.jlock.Unlock()
+}
+
+// flushJobs add progress reported since last flushed to jobs, retried next time if failed.
+func (r *Runtime) flushJobs(ctx context.Context) {
+	r.jlock.Lock()
+	reports := r.jobReports
+	r.jobReports = make(map[string]*jobProgress)
+	r.jlock.Unlock()
+
+	for jobID, progress := range reports {
+		_, err := r.repository.UpdateJob(ctx, jobID, func(job *dao.Job) {
+			job.Applied += progress.applied
+			job.Failed += progress.failed
+			finishJob(job)
+		})
+
+		switch {
+		case nil == err:
+		case errors.Is(err, xerrors.ErrJobNotFound):
+			log.L().Warn("flush job, job expired", zfield.ID(jobID))
+		default:
+			log.L().Error("flush job", zap.Error(err), zfield.ID(jobID))
+			r.jlock.Lock()
+			if pending, has := r.jobReports[jobID]; has {
+				pending.applied += progress.applied
+				pending.failed += progress.failed
+			} else {
+				r.jobReports[jobID] = progress
+			}
+			r.jlock.Unlock()
+		}
+	}
+}
+
+func (r *Runtime) putJob(ctx context.Context, job *dao.Job) {
+	if err := r.repository.PutJob(ctx, job); nil != err {
+		log.L().Error("put job", zap.Error(err), zfield.ID(job.ID))
+	}
+}
+
+func (r *Runtime) updateJob(ctx context.Context, id string, fn func(*dao.Job)) *dao.Job {
+	job, err := r.repository.UpdateJob(ctx, id, fn)
+	if nil != err {
+		log.L().Error("update job", zap.Error(err), zfield.ID(id))
+	}
+	return job
+}
+
+func (r *Runtime) putJobFailure(ctx context.Context, jobID, eid string, err error) {
+	if innerErr := r.repository.PutJobFailure(ctx, &dao.JobFailure{
+		JobID:     jobID,
+		EntityID:  eid,
+		Error:     err.Error(),
+		Timestamp: time.Now().UnixNano(),
+	}); nil != innerErr {
+		log.L().Error("put job failure", zap.Error(innerErr), zfield.ID(jobID), zfield.Eid(eid))
+	}
+}
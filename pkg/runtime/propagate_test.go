@@ -0,0 +1,105 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/core/pkg/scheme"
+	"github.com/tkeel-io/tdtl"
+)
+
+func TestRuntime_PropagateTemplate(t *testing.T) {
+	dispatcher := &eventRecorder{}
+	rt, repo := newTestRuntime(dispatcher, 0)
+
+	// index derived entities when created.
+	create := &v1.ProtoEvent{Metadata: map[string]string{}, Data: &v1.ProtoEvent_SystemData{
+		SystemData: &v1.SystemData{Operator: string(v1.OpCreate)}}}
+	rt.handleDerivation(context.Background(), &Feed{EntityID: "device123", Event: create,
+		State: []byte(`{"templates":["sensor","meter"]}`)})
+	rt.handleDerivation(context.Background(), &Feed{EntityID: "device234", Event: create,
+		State: []byte(`{"template_id":"sensor"}`)})
+	assert.Len(t, repo.Derivations, 3)
+
+	// running until derived entities applied.
+	job := rt.propagate(context.Background(), "sensor")
+	assert.Equal(t, dao.JobStatusRunning, job.Status)
+	assert.Equal(t, int64(2), job.Total)
+	assert.Equal(t, int64(2), job.Dispatched)
+	assert.Equal(t, *job, repo.Jobs[job.ID])
+	assert.Len(t, dispatcher.events, 2)
+	for _, ev := range dispatcher.events {
+		assert.Equal(t, "sensor", ev.Attr(v1.MetaTemplateID))
+		assert.Equal(t, job.ID, ev.Attr(v1.MetaJobID))
+	}
+
+	// nothing to propagate.
+	assert.Nil(t, rt.propagate(context.Background(), "device123"))
+
+	// stale derivation removed.
+	ev := &v1.ProtoEvent{Metadata: map[string]string{v1.MetaTemplateID: "sensor", v1.MetaJobID: job.ID}}
+	feed := rt.handleTemplate(context.Background(), &Feed{EntityID: "device234", Event: ev,
+		State: []byte(`{"template_id":"meter"}`)})
+	assert.Nil(t, feed.Err)
+	assert.Len(t, repo.Derivations, 2)
+	assert.NotContains(t, repo.Derivations, (&dao.Derivation{TemplateID: "sensor", EntityID: "device234"}).Key())
+	rt.reportJob(context.Background(), ev, feed)
+	// progress aggregated until flushed.
+	assert.Equal(t, int64(0), repo.Jobs[job.ID].Applied)
+	rt.flushJobs(context.Background())
+	assert.Equal(t, int64(1), repo.Jobs[job.ID].Applied)
+	assert.Equal(t, dao.JobStatusRunning, repo.Jobs[job.ID].Status)
+
+	// scheme inherited applied.
+	ev = &v1.ProtoEvent{Metadata: map[string]string{v1.MetaJobID: job.ID}}
+	rt.reportJob(context.Background(), ev, &Feed{EntityID: "device123", Event: ev})
+	rt.flushJobs(context.Background())
+	assert.Equal(t, int64(2), repo.Jobs[job.ID].Applied)
+	assert.Equal(t, dao.JobStatusSucceeded, repo.Jobs[job.ID].Status)
+	assert.Len(t, rt.jobReports, 0)
+
+	// derived entities deleted.
+	remove := &v1.ProtoEvent{Metadata: map[string]string{}, Data: &v1.ProtoEvent_SystemData{
+		SystemData: &v1.SystemData{Operator: string(v1.OpDelete)}}}
+	rt.handleDerivation(context.Background(), &Feed{EntityID: "device123", Event: remove,
+		State: []byte(`{"templates":["sensor","meter"]}`)})
+	assert.Len(t, repo.Derivations, 0)
+}
+
+func TestInheritPatches(t *testing.T) {
+	resolved := &scheme.Resolved{
+		Scheme:  map[string]json.RawMessage{"temp": []byte(`{"type":"int"}`)},
+		Origins: map[string]string{"temp": "sensor"}}
+
+	state := tdtl.New([]byte(`{"scheme":{},"scheme_origins":{}}`))
+	patches, err := inheritPatches(state, "device123", resolved, "")
+	assert.Nil(t, err)
+	assert.Len(t, patches, 2)
+
+	// scheme inherited already, nothing patched.
+	state = tdtl.New([]byte(`{"scheme":{"temp":{"type": "int"}},"scheme_origins":{"temp":"sensor"}}`))
+	patches, err = inheritPatches(state, "device123", resolved, "")
+	assert.Nil(t, err)
+	assert.Len(t, patches, 0)
+}
+
+func TestRuntime_SchedulePropagate(t *testing.T) {
+	rt, _ := newTestRuntime(&eventRecorder{}, 0)
+
+	// changed again before propagated, merged.
+	rt.schedulePropagate("sensor")
+	rt.schedulePropagate("meter")
+	rt.schedulePropagate("sensor")
+	assert.Equal(t, []string{"sensor", "meter"}, rt.propagateQueue)
+	assert.Len(t, rt.propagateCh, 1)
+
+	tid, ok := rt.nextPropagation()
+	assert.True(t, ok)
+	assert.Equal(t, "sensor", tid)
+	rt.schedulePropagate("sensor")
+	assert.Equal(t, []string{"meter", "sensor"}, rt.propagateQueue)
+}
@@ -0,0 +1,254 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core/pkg/dispatch"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	xkafka "github.com/tkeel-io/core/pkg/util/kafka"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/zap"
+)
+
+// nodeQueue queue managed by etcd.
+type nodeQueue struct {
+	queue dao.Queue
+	// id of runtime consuming the queue.
+	rid string
+	// stop campaigning for the runtime, nil if not assigned to the node.
+	cancel context.CancelFunc
+}
+
+// startRuntime create runtime consuming source, writes of the runtime guarded by the fence,
+// returns id of the runtime.
+func (n *Node) startRuntime(source string, f *fence) (string, error) {
+	sourceIns, err := xkafka.NewKafkaPubsub(source)
+	if nil != err {
+		return "", errors.Wrap(err, "create source instance")
+	}
+
+	rid := sourceIns.ID()
+	n.lock.RLock()
+	_, has := n.runtimes[rid]
+	n.lock.RUnlock()
+	if has {
+		sourceIns.Close()
+		return rid, errors.Errorf("runtime %s already exists", rid)
+	}
+
+	// create runtime instance.
+	log.L().Info("create runtime instance", zfield.ID(rid), zfield.Source(source))
+	entityResouce := f.guardResource(EntityResource{FlushHandler: n.FlushEntity, RemoveHandler: n.RemoveEntity})
+	repo := &fencedRepository{IRepository: n.resourceManager.Repo(), fence: f}
+	rt := NewRuntime(n.ctx, entityResouce, rid, n.dispatch, repo, n.conf.ResidencyLimit, n.conf.MaxEventHops)
+	rt.fence = f
+	rt.historyLimit = int64(n.conf.HistoryLimit)
+	rt.historyTypes = make(map[string]bool)
+	for _, typ := range n.conf.HistoryTypes {
+		rt.historyTypes[typ] = true
+	}
+	rt.snapshotEvery = n.conf.SnapshotInterval
+	rt.useStages(n.stages)
+	if n.conf.PersistWindow > 0 {
+		// written with node context, entities persisted after runtime stopped.
+		if rt.persister, err = newWriteBehind(n.ctx, rid, n.conf.PersistWindow,
+			f.guardBulk(n.FlushEntities), rt.flushHistories, rt.markFlushed); nil != err {
+			sourceIns.Close()
+			return rid, errors.Wrap(err, "create runtime persister")
+		}
+	}
+	// rehydrate cache before mappers initialized.
+	if err = rt.Restore(n.ctx); nil != err {
+		log.L().Error("restore runtime cache", zap.Error(err), zfield.ID(rid))
+	}
+
+	placement.Global().Append(placement.Info{ID: rid, Flag: true, Weight: sourceIns.Weight()})
+	for _, mp := range n.mapperSlice() {
+		if mc, has := n.mapper(mp)[rt.ID()]; has {
+			rt.AppendMapper(*mc)
+		}
+	}
+
+	n.lock.Lock()
+	n.runtimes[rid] = rt
+	n.sources[rid] = sourceIns
+	n.lock.Unlock()
+	go rt.Snapshot()
+	go rt.Schedule()
+	go rt.Propagate()
+
+	// consume until runtime stopped.
+	if err = sourceIns.Received(rt.ctx, n); nil != err {
+		n.stopRuntime(n.ctx, rid)
+		return rid, errors.Wrap(err, "consume source")
+	}
+
+	return rid, nil
+}
+
+// stopRuntime stop consuming and flush entities of runtime, the queue consumed by other node then.
+// entities of runtime fenced never flushed, the new owner consumes messages uncommitted again.
+func (n *Node) stopRuntime(ctx context.Context, rid string) {
+	n.lock.RLock()
+	rt, has := n.runtimes[rid]
+	sourceIns := n.sources[rid]
+	n.lock.RUnlock()
+	if !has {
+		return
+	}
+
+	// pause consumption, the consumer finishes the event in flight,
+	// commits offsets of messages which entities persisted before stopped.
+	rt.cancel()
+	select {
+	case <-sourceIns.Done():
+	case <-ctx.Done():
+		log.L().Warn("stop runtime, drain consumer", zap.Error(ctx.Err()), zfield.ID(rid))
+	}
+
+	n.lock.Lock()
+	if n.runtimes[rid] != rt {
+		// stopped concurrently.
+		n.lock.Unlock()
+		return
+	}
+	delete(n.runtimes, rid)
+	delete(n.sources, rid)
+	n.lock.Unlock()
+
+	// flush resident entities.
+	if rt.fence.Revoked() {
+		log.L().Warn("stop runtime fenced, entities discarded", zfield.ID(rid))
+	} else if err := rt.Flush(ctx); nil != err {
+		log.L().Error("stop runtime, flush entities", zap.Error(err), zfield.ID(rid))
+	}
+	if err := rt.Stop(ctx); nil != err {
+		log.L().Error("stop runtime", zap.Error(err), zfield.ID(rid))
+	}
+
+	placement.Global().Append(placement.Info{ID: rid, Weight: sourceIns.Weight()})
+	if err := sourceIns.Close(); nil != err {
+		log.L().Warn("close runtime source", zap.Error(err), zfield.ID(rid))
+	}
+}
+
+// listQueues start runtimes of queues assigned to the node.
+func (n *Node) listQueues() {
+	repo := n.resourceManager.Repo()
+	repo.RangeQueue(n.ctx, n.revision, func(queues []dao.Queue) {
+		for _, q := range queues {
+			n.putQueue(q)
+		}
+	})
+}
+
+// watchQueues create or tear down runtimes as queues changed.
+func (n *Node) watchQueues() {
+	repo := n.resourceManager.Repo()
+	repo.WatchQueue(n.ctx, n.revision, func(et dao.EnventType, q dao.Queue) {
+		switch et {
+		case dao.PUT:
+			n.putQueue(q)
+		case dao.DELETE:
+			n.delQueue(q.ID)
+		}
+	})
+}
+
+func (n *Node) putQueue(q dao.Queue) {
+	if q.ConsumerType != dao.ConsumerTypeCore {
+		return
+	} else if q.Type != dao.QueueTypeKafkaTopic {
+		log.L().Warn("queue type not supported", zfield.ID(q.ID), zfield.Type(q.Type.String()))
+		return
+	}
+
+	n.qlock.Lock()
+	defer n.qlock.Unlock()
+
+	nq, has := n.queues[q.ID]
+	if has && nq.queue.URL() != q.URL() {
+		// queue moved to another topic.
+		n.removeQueue(nq)
+		has = false
+	}
+
+	log.L().Info("put queue", zfield.ID(q.ID), zfield.URL(q.URL()), zfield.Name(q.NodeName))
+	if !has {
+		rid, err := xkafka.ParseID(q.URL())
+		if nil != err {
+			log.L().Error("put queue", zap.Error(err), zfield.ID(q.ID), zfield.URL(q.URL()))
+			return
+		}
+
+		if ds, ok := n.dispatch.(dispatch.Downstreams); ok {
+			if _, err = ds.AppendDownstream(q.URL()); nil != err {
+				log.L().Error("append downstream", zap.Error(err), zfield.ID(q.ID), zfield.URL(q.URL()))
+				return
+			}
+		}
+		nq = &nodeQueue{rid: rid}
+		n.queues[q.ID] = nq
+	}
+	nq.queue = q
+
+	// runtime started once the node owns it, the previous node released.
+	switch local := q.NodeName == n.conf.NodeName; {
+	case local && nil == nq.cancel:
+		nq.cancel = n.campaign(q.URL())
+	case !local && nil != nq.cancel:
+		nq.cancel()
+		nq.cancel = nil
+	}
+
+	n.reloadMappers()
+	n.reloadSchedules()
+}
+
+func (n *Node) delQueue(id string) {
+	n.qlock.Lock()
+	defer n.qlock.Unlock()
+	if nq, has := n.queues[id]; has {
+		log.L().Info("delete queue", zfield.ID(id), zfield.URL(nq.queue.URL()))
+		n.removeQueue(nq)
+		n.reloadMappers()
+		n.reloadSchedules()
+	}
+}
+
+func (n *Node) removeQueue(nq *nodeQueue) {
+	if nil != nq.cancel {
+		nq.cancel()
+	}
+	if ds, ok := n.dispatch.(dispatch.Downstreams); ok {
+		ds.RemoveDownstream(nq.rid)
+	}
+	delete(n.queues, nq.queue.ID)
+}
+
+// reloadMappers place mappers to runtimes after topology changed.
+func (n *Node) reloadMappers() {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	for _, mp := range n.mapperSlice() {
+		for rid, mc := range n.mapper(mp) {
+			if rt, has := n.runtimes[rid]; has && !rt.hasMapper(*mc) {
+				rt.AppendMapper(*mc)
+			}
+		}
+	}
+}
+
+// reloadSchedules sync periodic jobs of runtimes after topology changed, jobs placed to runtimes changed.
+func (n *Node) reloadSchedules() {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	for rid, rt := range n.runtimes {
+		if err := rt.reloadSchedules(n.ctx); nil != err {
+			log.L().Error("reload schedules", zap.Error(err), zfield.ID(rid))
+		}
+	}
+}
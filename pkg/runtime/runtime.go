@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -13,18 +14,21 @@ import (
 	"github.com/Shopify/sarama"
 	"github.com/pkg/errors"
 	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/deadletter"
 	"github.com/tkeel-io/core/pkg/dispatch"
 	xerrors "github.com/tkeel-io/core/pkg/errors"
 	zfield "github.com/tkeel-io/core/pkg/logger"
 	"github.com/tkeel-io/core/pkg/mapper"
 	"github.com/tkeel-io/core/pkg/placement"
 	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/repository/dao"
 	"github.com/tkeel-io/core/pkg/types"
 	"github.com/tkeel-io/core/pkg/util"
 	xjson "github.com/tkeel-io/core/pkg/util/json"
 	"github.com/tkeel-io/core/pkg/util/path"
 	"github.com/tkeel-io/kit/log"
 	"github.com/tkeel-io/tdtl"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
 
@@ -45,25 +49,67 @@ type Runtime struct {
 	pathTree        *path.Tree
 	tentacleTree    *path.Tree
 	enCache         EntityCache
-	entities        map[string]Entity // 存放Runtime的实体.
+	entities        *residency // 存放Runtime的实体.
 	dispatcher      dispatch.Dispatcher
 	mapperCaches    map[string]MCache
 	repository      repository.IRepository
 	entityResourcer EntityResource
-
+	maxHops         int                   // 派生事件最大传播次数.
+	historyLimit    int64                 // 每个实体保留的变更历史数.
+	historyTypes    map[string]bool       // 记录变更历史的实体类型.
+	histories       map[string]int        // 实体自上次快照以来的变更历史数.
+	txs             map[string]*pendingTx // 被事务锁定的实体.
+	replays         []v1.Event            // 事务结束后待重放的事件.
+	released        []*dao.TxLock         // 事务结束后待删除的实体锁.
+	schedules       map[string]*periodJob
+	senderBases     map[string]*senderBase         // 发送方最近一次变更前的状态, 订阅间共享.
+	persister       *writeBehind                   // 实体延迟批量持久化, nil 时同步持久化.
+	pipeline        *pipeline                      // 实体事件处理阶段.
+	windows         map[string]*mapper.WindowState // mapper 输入的时间窗口.
+	windowsDirty    bool                           // 窗口自上次快照以来是否变更.
+	fence           *fence                         // 所有权租约失效后拒绝写入, nil 时不受限.
+	snapshotEvery   time.Duration                  // 缓存快照周期, 0 时不做周期快照.
+	nextSnapshot    time.Time                      // 下一次缓存快照的时间.
+	snapshots       chan *CacheSnapshot            // 事件循环内截取, 待持久化的缓存快照.
+	dropped         *atomic.Int64                  // 超过最大传播次数被丢弃的事件数.
+	dropLogs        *throttle                      // 丢弃事件的日志限流.
+	propagations    map[string]struct{}            // 待传播 scheme 的模板, 重复变更合并.
+	propagateQueue  []string                       // 按变更顺序待传播的模板.
+	propagateCh     chan struct{}                  // 通知传播协程.
+	jobReports      map[string]*jobProgress        // 自上次刷新以来上报的任务进度.
+
+	elock  sync.Mutex
+	hlock  sync.Mutex
+	jlock  sync.Mutex
+	plock  sync.Mutex
+	tlock  sync.Mutex
+	slock  sync.Mutex
+	wlock  sync.Mutex
 	mlock  sync.RWMutex
 	lock   sync.RWMutex
 	ctx    context.Context
 	cancel context.CancelFunc
 }
 
-func NewRuntime(ctx context.Context, ercFuncs EntityResource, id string, dispatcher dispatch.Dispatcher, repository repository.IRepository) *Runtime {
+func NewRuntime(ctx context.Context, ercFuncs EntityResource, id string, dispatcher dispatch.Dispatcher, repository repository.IRepository, residencyLimit, maxHops int) *Runtime {
 	ctx, cancel := context.WithCancel(ctx)
-	return &Runtime{
+	rt := &Runtime{
 		id:              id,
-		enCache:         NewCache(repository),
-		entities:        map[string]Entity{},
+		maxHops:         maxHops,
+		enCache:         NewCache(id, repository),
+		entities:        newResidency(residencyLimit),
 		mapperCaches:    map[string]MCache{},
+		schedules:       map[string]*periodJob{},
+		senderBases:     map[string]*senderBase{},
+		windows:         map[string]*mapper.WindowState{},
+		histories:       map[string]int{},
+		txs:             map[string]*pendingTx{},
+		snapshots:       make(chan *CacheSnapshot, 1),
+		dropped:         atomic.NewInt64(0),
+		dropLogs:        newThrottle(time.Minute),
+		propagations:    map[string]struct{}{},
+		propagateCh:     make(chan struct{}, 1),
+		jobReports:      map[string]*jobProgress{},
 		entityResourcer: ercFuncs,
 		dispatcher:      dispatcher,
 		repository:      repository,
@@ -74,34 +120,231 @@ func NewRuntime(ctx context.Context, ercFuncs EntityResource, id string, dispatc
 		cancel:          cancel,
 		ctx:             ctx,
 	}
+
+	rt.pipeline = newPipeline(rt.builtinHandlers(), nil)
+	return rt
+}
+
+// builtinHandlers returns handlers of built-in stages.
+func (r *Runtime) builtinHandlers() map[string]Handler {
+	return map[string]Handler{
+		StageRawData:    &handlerImpl{fn: r.handleRawData},
+		StageTentacle:   &handlerImpl{fn: r.handleTentacle},
+		StageComputed:   &handlerImpl{fn: r.handleComputed},
+		StagePersistent: &handlerImpl{fn: r.handlePersistent},
+		StageHistory:    &handlerImpl{fn: r.handleHistory},
+		StageTemplate:   &handlerImpl{fn: r.handleTemplate},
+		StageSchedule:   &handlerImpl{fn: r.handleSchedule},
+		StageDerivation: &handlerImpl{fn: r.handleDerivation},
+		StagePropagate:  &handlerImpl{fn: r.handlePropagate},
+	}
+}
+
+// useStages combine custom stages into pipeline, called before runtime started.
+func (r *Runtime) useStages(stages []*stage) {
+	r.pipeline = newPipeline(r.builtinHandlers(), stages)
 }
 
 func (r *Runtime) ID() string {
 	return r.id
 }
 
+// Restore rehydrate runtime cache from the last snapshot.
+func (r *Runtime) Restore(ctx context.Context) error {
+	log.L().Info("restore runtime cache", zfield.ID(r.id))
+	if err := r.enCache.Restore(ctx); nil != err {
+		return errors.Wrap(err, "restore runtime")
+	}
+	if err := r.restoreWindows(ctx); nil != err {
+		return errors.Wrap(err, "restore runtime")
+	}
+	if err := r.restoreTxs(ctx); nil != err {
+		return errors.Wrap(err, "restore runtime")
+	}
+	return errors.Wrap(r.restoreSchedules(ctx), "restore runtime")
+}
+
+// Snapshot persist runtime cache captured by ticks until runtime stopped.
+func (r *Runtime) Snapshot() {
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case snap := <-r.snapshots:
+			if err := r.enCache.Persist(r.ctx, snap); nil != err {
+				log.L().Error("snapshot runtime cache", zap.Error(err), zfield.ID(r.id))
+			}
+			if err := r.snapshotWindows(r.ctx); nil != err {
+				log.L().Error("snapshot runtime windows", zap.Error(err), zfield.ID(r.id))
+			}
+			log.L().Debug("runtime entity residency", zfield.ID(r.id), zap.Any("stats", r.Stats()))
+			log.L().Debug("runtime stages", zfield.ID(r.id), zap.Any("stats", r.StageStats()))
+		}
+	}
+}
+
+func (r *Runtime) Stop(ctx context.Context) error {
+	log.L().Info("stop runtime", zfield.ID(r.id))
+	r.cancel()
+	if nil != r.persister {
+		r.persister.Close()
+	}
+	if r.fence.Revoked() {
+		// ownership lost, snapshots written by the new owner.
+		return nil
+	}
+	if err := r.snapshotWindows(ctx); nil != err {
+		log.L().Error("snapshot runtime windows", zap.Error(err), zfield.ID(r.id))
+	}
+
+	// wait the tick in flight.
+	r.elock.Lock()
+	defer r.elock.Unlock()
+	return errors.Wrap(r.enCache.Snapshot(ctx), "stop runtime")
+}
+
+// Flush persist dirty resident entities, evicting flushed entities over the residency limit.
+func (r *Runtime) Flush(ctx context.Context) error {
+	var err error
+	var flushed int
+	elapsed := util.NewElapsed()
+	if nil != r.persister {
+		for _, entry := range r.entities.DirtyEntities() {
+			if err = r.persister.Put(ctx, entry.entity, entry.writes); nil != err {
+				return errors.Wrap(err, "flush runtime")
+			}
+		}
+		return errors.Wrap(r.persister.Flush(ctx), "flush runtime")
+	}
+
+	for _, entry := range r.entities.DirtyEntities() {
+		if innerErr := r.entityResourcer.FlushHandler(ctx, entry.entity); nil != innerErr {
+			err = errors.Wrapf(innerErr, "flush entity %s", entry.id)
+			continue
+		}
+		r.entities.MarkFlushed(entry.id, entry.writes)
+		flushed++
+	}
+
+	log.L().Info("flush runtime entities", zfield.ID(r.id),
+		zap.Int("flushed", flushed), zfield.Elapsedms(elapsed.ElapsedMilli()))
+	return errors.Wrap(err, "flush runtime")
+}
+
+// FlushPending wait entities written behind persisted, then release locks of transactions completed.
+func (r *Runtime) FlushPending(ctx context.Context) error {
+	if nil != r.persister {
+		if err := r.persister.Flush(ctx); nil != err {
+			return errors.Wrap(err, "flush pending")
+		}
+	}
+	return errors.Wrap(r.flushTxs(ctx), "flush pending")
+}
+
+// markFlushed mark entities written behind flushed, unless written again since.
+func (r *Runtime) markFlushed(flushed map[string]int64) {
+	for id, writes := range flushed {
+		if evicted := r.entities.MarkFlushed(id, writes); len(evicted) > 0 {
+			log.L().Debug("evict entities", zfield.ID(r.id), zap.Strings("entities", evicted))
+		}
+	}
+}
+
+// Dropped returns number of events dropped for hops exceeded.
+func (r *Runtime) Dropped() int64 {
+	return r.dropped.Load()
+}
+
+// StageStats returns statistics of event handling stages.
+func (r *Runtime) StageStats() map[string]StageStats {
+	return r.pipeline.Stats()
+}
+
+// Stats returns runtime entity residency statistics.
+func (r *Runtime) Stats() ResidencyStats {
+	return r.entities.Stats()
+}
+
 func (r *Runtime) DeliveredEvent(ctx context.Context, msg *sarama.ConsumerMessage) {
 	var err error
 	var ev v1.ProtoEvent
 	if err = v1.Unmarshal(msg.Value, &ev); nil != err {
 		log.L().Error("decode Event", zap.Error(err))
+		if err = deadletter.Global().Push(ctx, &deadletter.Letter{
+			RuntimeID: r.id,
+			Raw:       msg.Value,
+			Err:       errors.Wrap(err, "decode event"),
+			Attempts:  1,
+		}); nil != err {
+			log.L().Error("push dead letter", zap.Error(err), zfield.ID(r.id))
+		}
 		return
 	}
 
+	// events consumed handled in order with timers of runtime.
+	r.elock.Lock()
+	defer r.elock.Unlock()
 	r.HandleEvent(ctx, &ev)
 }
 
 func (r *Runtime) HandleEvent(ctx context.Context, event v1.Event) error {
+	// events of batch handled in order, each responds to its own caller.
+	if batch, ok := event.(v1.BatchEvent); ok && batch.Type() == v1.ETBatch {
+		for _, ev := range batch.Events() {
+			r.HandleEvent(ctx, ev)
+		}
+		return nil
+	}
+
+	// drop derived events which propagated too far, mappers may ping-pong.
+	hops := eventHops(event)
+	if r.maxHops > 0 && hops > r.maxHops {
+		// counted rather than dead lettered, ping-pong mappers would flood the dead letter queue.
+		dropped := r.dropped.Inc()
+		if suppressed, ok := r.dropLogs.Allow(time.Now()); ok {
+			log.L().Warn("drop event, hops exceeded", zfield.ID(event.ID()), zfield.Eid(event.Entity()),
+				zap.Error(xerrors.ErrEventHopsExceeded), zap.Int("hops", hops), zap.Int("max_hops", r.maxHops),
+				zap.Int64("dropped", dropped), zap.Int64("suppressed", suppressed))
+		}
+		return nil
+	}
+
+	// timers of runtime handled within event loop.
+	if isTick(event) {
+		r.handleTick(ctx, event)
+		return nil
+	}
+
+	// events of entity locked by transaction deferred until transaction completed.
+	if r.deferTxEvent(event) {
+		return nil
+	}
+
 	execer, feed := r.PrepareEvent(ctx, event)
+	feed.TTL = hops
 	feed = execer.Exec(ctx, feed)
 
 	// call callback once.
 	r.handleCallback(ctx, feed)
+	r.reportJob(ctx, event, feed)
 	if nil != feed.Err {
 		log.Error("handle event", zap.Error(feed.Err),
 			zfield.ID(event.ID()), zfield.Eid(event.Entity()), zfield.Event(event))
+		// errors of callback events already responded to the caller.
+		if event.CallbackAddr() == "" {
+			if err := deadletter.Global().Push(ctx, &deadletter.Letter{
+				RuntimeID: r.id,
+				Event:     event,
+				Err:       feed.Err,
+				Attempts:  1,
+			}); nil != err {
+				log.L().Error("push dead letter", zap.Error(err), zfield.ID(r.id))
+			}
+		}
 	}
 
+	// replay events deferred by completed transaction.
+	r.replayTxEvents(ctx)
 	return nil
 }
 
@@ -111,30 +354,26 @@ func (r *Runtime) PrepareEvent(ctx context.Context, ev v1.Event) (*Execer, *Feed
 	switch ev.Type() {
 	case v1.ETSystem:
 		execer, feed := r.prepareSystemEvent(ctx, ev)
-		execer.postFuncs = append(execer.postFuncs,
-			&handlerImpl{fn: r.handlePersistent})
+		execer.postFuncs = append(execer.postFuncs, r.pipeline.Named(systemStages...)...)
 		return execer, feed
 	case v1.ETEntity:
+		// phases of multi-entity transaction.
+		if ev.Attr(v1.MetaTxPhase) != "" {
+			return r.prepareTxEvent(ctx, ev)
+		}
+
 		e, _ := ev.(v1.PatchEvent)
-		state, err := r.LoadEntity(ev.Entity())
+		state, err := r.loadEntity(ev.Entity(), true)
 		if nil != err {
 			log.L().Error("load entity", zfield.Eid(ev.Entity()),
 				zap.Error(err), zfield.ID(ev.ID()), zfield.Header(ev.Attributes()))
 			state = DefaultEntity(ev.Entity())
+		} else {
+			// reject templates which missing or cyclic.
+			err = r.checkTemplates(state, conv(e.Patches()))
 		}
 
-		execer := &Execer{
-			state: state,
-			preFuncs: []Handler{
-				&handlerImpl{fn: r.handleRawData}},
-			execFunc: state,
-			postFuncs: []Handler{
-				&handlerImpl{fn: r.handleTentacle},
-				&handlerImpl{fn: r.handleComputed},
-				&handlerImpl{fn: r.handlePersistent},
-				&handlerImpl{fn: r.handleTemplate}}}
-
-		return execer, &Feed{
+		return r.entityExecer(state), &Feed{
 			Err:      err,
 			Event:    ev,
 			State:    state.Raw(),
@@ -172,18 +411,13 @@ func (r *Runtime) PrepareEvent(ctx context.Context, ev v1.Event) (*Execer, *Feed
 	}
 }
 
-func (r *Runtime) loadTemplate(tid string) (tdtl.Node, error) {
-	if strings.TrimSpace(tid) == "" {
-		return tdtl.New(`{}`), nil
-	}
-
-	ten, err := r.LoadEntity(tid)
-	if nil != err {
-		log.L().Error("load template", zap.Error(err), zfield.Eid(tid))
-		return nil, errors.Wrap(err, "load template")
-	}
-
-	return ten.Get(FieldScheme), nil
+// entityExecer returns execer which patch entity.
+func (r *Runtime) entityExecer(state Entity) *Execer {
+	return &Execer{
+		state:     state,
+		preFuncs:  r.pipeline.Handlers(PhasePre, state.Type()),
+		execFunc:  state,
+		postFuncs: r.pipeline.Handlers(PhasePost, state.Type())}
 }
 
 // 处理实体生命周期.
@@ -212,7 +446,7 @@ func (r *Runtime) prepareSystemEvent(ctx context.Context, event v1.Event) (*Exec
 			}}
 
 		// check entity exists.
-		if _, exists := r.entities[ev.Entity()]; exists {
+		if r.existEntity(ctx, ev.Entity()) {
 			return execer, &Feed{
 				Event:    ev,
 				EntityID: ev.Entity(),
@@ -230,11 +464,28 @@ func (r *Runtime) prepareSystemEvent(ctx context.Context, event v1.Event) (*Exec
 				EntityID: ev.Entity()}
 		}
 
-		var scheme tdtl.Node
-		templateID := state.Get(FieldTemplate).String()
-		if scheme, err = r.loadTemplate(templateID); nil != err {
-			log.L().Error("load template", zap.Error(err),
-				zfield.Eid(ev.Entity()), zfield.Template(templateID))
+		node, err := inheritNode(ev.Entity(), tdtl.New(state.Raw()))
+		if nil != err {
+			return execer, &Feed{
+				Err:      err,
+				Event:    ev,
+				EntityID: ev.Entity()}
+		}
+
+		// scheme of creating entity is local.
+		node.Origins = map[string]string{}
+		resolved, mode, err := r.resolveScheme(node)
+		if nil != err {
+			log.L().Error("resolve scheme", zap.Error(err),
+				zfield.Eid(ev.Entity()), zap.Strings("templates", node.Templates))
+			return execer, &Feed{
+				Err:      err,
+				Event:    ev,
+				EntityID: ev.Entity()}
+		}
+
+		inherits, err := inheritPatches(tdtl.New(state.Raw()), ev.Entity(), resolved, mode)
+		if nil != err {
 			return execer, &Feed{
 				Err:      err,
 				Event:    ev,
@@ -242,22 +493,20 @@ func (r *Runtime) prepareSystemEvent(ctx context.Context, event v1.Event) (*Exec
 		}
 
 		props := state.Get(FieldProperties)
-		r.entities[ev.Entity()] = state
+		r.entities.Put(ev.Entity(), state, true)
 		execer.state = state
 		execer.execFunc = state
+		patches := append([]Patch{{
+			Op:    xjson.OpMerge,
+			Path:  FieldProperties,
+			Value: tdtl.New(props.Raw())}}, inherits...)
+
 		return execer, &Feed{
 			Err:      props.Error(),
 			Event:    ev,
 			State:    state.Raw(),
 			EntityID: ev.Entity(),
-			Patches: []Patch{{
-				Op:    xjson.OpMerge,
-				Path:  FieldProperties,
-				Value: tdtl.New(props.Raw())}, {
-				Op:    xjson.OpReplace,
-				Path:  FieldScheme,
-				Value: tdtl.New(scheme.Raw()),
-			}}}
+			Patches:  patches}
 	case v1.OpDelete:
 		state, err := r.LoadEntity(ev.Entity())
 		if nil != err {
@@ -281,6 +530,13 @@ func (r *Runtime) prepareSystemEvent(ctx context.Context, event v1.Event) (*Exec
 			execFunc: state,
 			preFuncs: []Handler{
 				&handlerImpl{fn: func(ctx context.Context, feed *Feed) *Feed {
+					if innerErr := checkVersion(state, ev); nil != innerErr {
+						log.L().Warn("delete entity", zfield.Eid(ev.Entity()),
+							zfield.Reason(innerErr.Error()), zfield.ID(ev.ID()), zfield.Header(ev.Attributes()))
+						feed.Err = innerErr
+						return feed
+					}
+
 					if innerErr := r.entityResourcer.RemoveHandler(ctx, state); nil != innerErr {
 						log.L().Error("delete entity failure", zfield.Eid(ev.Entity()),
 							zap.Error(innerErr), zfield.ID(ev.ID()), zfield.Header(ev.Attributes()))
@@ -289,7 +545,7 @@ func (r *Runtime) prepareSystemEvent(ctx context.Context, event v1.Event) (*Exec
 					}
 
 					// remove entity from runtime.
-					delete(r.entities, state.ID())
+					r.entities.Remove(state.ID())
 
 					return feed
 				}}},
@@ -329,12 +585,22 @@ func (r *Runtime) handleComputed(ctx context.Context, feed *Feed) *Feed {
 	// 1. 检查 ret.path 和 订阅列表.
 	entityID := feed.EntityID
 	mappers := make(map[string]mapper.Mapper)
+	changed := make(map[string]map[string]bool)
 	for _, change := range feed.Changes {
-		for _, node := range r.pathTree.
-			MatchPrefix(path.FmtWatchKey(entityID, change.Path)) {
+		watchKey := path.FmtWatchKey(entityID, change.Path)
+		for _, node := range r.pathTree.MatchPrefix(watchKey) {
 			tentacle, _ := node.(mapper.Tentacler)
 			if tentacle.Type() == mapper.TentacleTypeMapper {
-				mappers[tentacle.TargetID()] = tentacle.Mapper()
+				id := tentacle.TargetID()
+				mappers[id] = tentacle.Mapper()
+				if nil == changed[id] {
+					changed[id] = make(map[string]bool)
+				}
+				for _, item := range tentacle.Items() {
+					if key := item.String(); key == watchKey || strings.HasPrefix(key, watchKey+".") {
+						changed[id][key] = true
+					}
+				}
 			}
 		}
 	}
@@ -344,7 +610,7 @@ func (r *Runtime) handleComputed(ctx context.Context, feed *Feed) *Feed {
 		target := mp.TargetEntity()
 		log.L().Debug("compute mapper",
 			zfield.Eid(entityID), zfield.Mid(id))
-		result := r.computeMapper(ctx, mp)
+		result := r.computeMapper(ctx, mp, changed[id], eventTime(feed.Event))
 		for path, val := range result {
 			patches[target] = append(
 				patches[target],
@@ -362,6 +628,7 @@ func (r *Runtime) handleComputed(ctx context.Context, feed *Feed) *Feed {
 			Id:        util.IG().EvID(),
 			Timestamp: time.Now().UnixNano(),
 			Metadata: map[string]string{
+				v1.MetaTTL:      strconv.Itoa(feed.TTL + 1),
 				v1.MetaType:     string(v1.ETEntity),
 				v1.MetaEntityID: target},
 			Data: &v1.ProtoEvent_Patches{
@@ -374,7 +641,8 @@ func (r *Runtime) handleComputed(ctx context.Context, feed *Feed) *Feed {
 	return feed
 }
 
-func (r *Runtime) computeMapper(ctx context.Context, mp mapper.Mapper) map[string]tdtl.Node {
+// computeMapper exec mapper with changed inputs at the time of event, all inputs treated as changed if nil.
+func (r *Runtime) computeMapper(ctx context.Context, mp mapper.Mapper, changed map[string]bool, at time.Time) map[string]tdtl.Node {
 	var (
 		has bool
 		err error
@@ -394,10 +662,17 @@ func (r *Runtime) computeMapper(ctx context.Context, mp mapper.Mapper) map[strin
 		for _, item := range tentacle.Items() {
 			var state Entity
 			// get value from entities.
-			if state, has = r.entities[item.EntityID]; has {
+			if state, has = r.entities.Get(item.EntityID); has {
 				in[item.String()] = state.Get(item.PropertyKey)
 				continue
 			}
+			// get value from state storage if entity evicted.
+			if placement.Global().Select(item.EntityID).ID == r.id {
+				if state, err = r.LoadEntity(item.EntityID); nil == err {
+					in[item.String()] = state.Get(item.PropertyKey)
+				}
+				continue
+			}
 			// get value from cache.
 			if state, err = r.enCache.Load(ctx, item.EntityID); nil == err {
 				in[item.String()] = state.Get(item.PropertyKey)
@@ -405,6 +680,11 @@ func (r *Runtime) computeMapper(ctx context.Context, mp mapper.Mapper) map[strin
 		}
 	}
 
+	// aggregate windowed inputs.
+	if len(mp.Windows()) > 0 && !r.applyWindows(mp, in, changed, at) {
+		return map[string]tdtl.Node{}
+	}
+
 	// ignore empty input.
 	if len(in) == 0 {
 		log.L().Warn("ignore empty input", zfield.Mid(mp.ID()))
@@ -467,7 +747,8 @@ func (r *Runtime) handleTentacle(ctx context.Context, feed *Feed) *Feed {
 		targets = []string{}
 	}
 
-	// 2. dispatch.send()
+	// 2. dispatch.send(), events of the same change share version of sender.
+	version := tdtl.New(feed.State).Get(FieldVersion).String()
 	for target, patch := range patches {
 		// check target entity placement.
 		info := placement.Global().Select(target)
@@ -485,9 +766,11 @@ func (r *Runtime) handleTentacle(ctx context.Context, feed *Feed) *Feed {
 			Id:        util.IG().EvID(),
 			Timestamp: time.Now().UnixNano(),
 			Metadata: map[string]string{
+				v1.MetaTTL:      strconv.Itoa(feed.TTL + 1),
 				v1.MetaType:     string(v1.ETCache),
 				v1.MetaEntityID: target,
-				v1.MetaSender:   entityID},
+				v1.MetaSender:   entityID,
+				v1.MetaVersion:  version},
 			Data: &v1.ProtoEvent_Patches{
 				Patches: &v1.PatchDatas{
 					Patches: patch,
@@ -542,59 +825,31 @@ func (r *Runtime) handleCallback(ctx context.Context, feed *Feed) error {
 
 func (r *Runtime) handlePersistent(ctx context.Context, feed *Feed) *Feed {
 	log.L().Debug("handle persistent", zfield.Eid(feed.EntityID))
-	en, ok := r.entities[feed.EntityID]
+	en, ok := r.entities.Get(feed.EntityID)
 	if !ok {
 		// entity has been deleted.
 		return feed
 	}
-	r.entityResourcer.FlushHandler(ctx, en)
-	return feed
-}
 
-func (r *Runtime) handleTemplate(ctx context.Context, feed *Feed) *Feed {
-	log.L().Debug("handle template", zfield.Eid(feed.EntityID))
-	for index := range feed.Changes {
-		if FieldTemplate == feed.Changes[index].Path {
-			log.Info("entity template changed", zfield.Eid(feed.EntityID),
-				zfield.Template(feed.Changes[index].Value.String()))
-			feed.Err = r.onTemplateChanged(ctx,
-				feed.EntityID, feed.Changes[index].Value.String())
-			break
+	// keep entity resident until written behind.
+	writes := r.entities.Writes(feed.EntityID)
+	if nil != r.persister {
+		if err := r.persister.Put(ctx, en, writes); nil != err {
+			log.L().Error("write entity behind", zap.Error(err), zfield.Eid(feed.EntityID))
 		}
+		return feed
 	}
-	return feed
-}
 
-func (r *Runtime) onTemplateChanged(ctx context.Context, entityID, templateID string) error {
-	log.L().Debug("entity template changed", zfield.Eid(entityID), zfield.Template(templateID))
-	// load template entity.
-	templateIns, err := r.LoadEntity(templateID)
-	if nil != err {
-		log.L().Error("onTemplateChanged", zap.Error(err),
-			zfield.Eid(entityID), zfield.Template(templateID))
-		return errors.Wrap(err, "On Template Changed")
-	}
-
-	// 为什么使用dispatch 异步更新scheme， 而不是直接更新？
-	// 1. 将 templateID 更新 scheme 更新分离，降低 api调用时延.
-	// 2.
-
-	ev := &v1.ProtoEvent{
-		Id:        entityID,
-		Timestamp: time.Now().UnixNano(),
-		Metadata: map[string]string{
-			v1.MetaType:     string(v1.ETEntity),
-			v1.MetaEntityID: entityID,
-			v1.MetaSender:   entityID},
-		Data: &v1.ProtoEvent_Patches{
-			Patches: &v1.PatchDatas{
-				Patches: []*v1.PatchData{{
-					Path:     FieldScheme,
-					Value:    templateIns.Scheme().Raw(),
-					Operator: xjson.OpReplace.String()},
-				}}}}
-	err = r.dispatcher.Dispatch(ctx, ev)
-	return errors.Wrap(err, "On Template Changed")
+	if err := r.entityResourcer.FlushHandler(ctx, en); nil != err {
+		// keep entity resident until flushed.
+		return feed
+	}
+
+	// evict flushed entities.
+	if evicted := r.entities.MarkFlushed(feed.EntityID, writes); len(evicted) > 0 {
+		log.L().Debug("evict entities", zfield.ID(r.id), zap.Strings("entities", evicted))
+	}
+	return feed
 }
 
 type tsData struct {
@@ -744,7 +999,7 @@ func (r *Runtime) initializeMapper(ctx context.Context, mc MCache) {
 			target := mp.TargetEntity()
 			log.L().Debug("compute mapper",
 				zfield.Eid(mc.EntityID), zfield.Mid(mc.ID))
-			result := r.computeMapper(ctx, mp)
+			result := r.computeMapper(ctx, mp, nil, time.Now())
 			for path, val := range result {
 				patches = append(
 					patches,
@@ -818,6 +1073,14 @@ func (r *Runtime) initializeMapper(ctx context.Context, mc MCache) {
 	}
 }
 
+// hasMapper returns true if the mapper cached with the same tentacles.
+func (r *Runtime) hasMapper(mc MCache) bool {
+	r.mlock.RLock()
+	defer r.mlock.RUnlock()
+	cached, has := r.mapperCaches[mc.ID]
+	return has && len(cached.Tentacles) == len(mc.Tentacles)
+}
+
 func (r *Runtime) RemoveMapper(mc MCache) {
 	r.mlock.Lock()
 	defer r.mlock.Unlock()
@@ -827,6 +1090,7 @@ func (r *Runtime) RemoveMapper(mc MCache) {
 
 	mc = r.mapperCaches[mc.ID]
 	delete(r.mapperCaches, mc.ID)
+	r.removeWindows(mc.ID)
 	for _, tentacle := range mc.Tentacles {
 		for _, item := range tentacle.Items() {
 			r.pathTree.Remove(item.String(), tentacle)
@@ -837,12 +1101,27 @@ func (r *Runtime) RemoveMapper(mc MCache) {
 }
 
 func (r *Runtime) LoadEntity(id string) (Entity, error) {
-	r.lock.Lock()
-	if state, ok := r.entities[id]; ok {
-		r.lock.Unlock()
+	return r.loadEntity(id, false)
+}
+
+// loadEntity load entity, entity loaded for update will not be evicted until flushed.
+func (r *Runtime) loadEntity(id string, forUpdate bool) (Entity, error) {
+	getter := r.entities.Get
+	if forUpdate {
+		getter = r.entities.GetForUpdate
+	}
+
+	if state, ok := getter(id); ok {
 		return state, nil
 	}
-	r.lock.Unlock()
+
+	// entity evicted may be written behind and not persisted yet, newer than state storage.
+	if nil != r.persister {
+		if en, ok := r.persister.Get(id); ok {
+			r.entities.Put(id, en, forUpdate)
+			return en, nil
+		}
+	}
 
 	// load from state storage.
 	jsonData, err := r.repository.GetEntity(context.TODO(), id)
@@ -860,19 +1139,37 @@ func (r *Runtime) LoadEntity(id string) (Entity, error) {
 		return nil, errors.Wrap(err, "create entity instance")
 	}
 
-	r.lock.Lock()
-	r.entities[id] = en
-	r.lock.Unlock()
-
+	r.entities.Put(id, en, forUpdate)
 	return en, nil
 }
 
+func (r *Runtime) existEntity(ctx context.Context, id string) bool {
+	if r.entities.Has(id) {
+		return true
+	} else if nil != r.persister {
+		if _, ok := r.persister.Get(id); ok {
+			return true
+		}
+	}
+
+	// entity may be evicted.
+	bytes, err := r.repository.GetEntity(ctx, id)
+	return nil == err && len(bytes) > 0
+}
+
+// eventHops returns times the event derived by mappers.
+func eventHops(ev v1.Event) int {
+	hops, _ := strconv.Atoi(ev.Attr(v1.MetaTTL))
+	return hops
+}
+
 func conv(patches []*v1.PatchData) []Patch {
 	res := make([]Patch, 0)
 	for _, patch := range patches {
 		res = append(res, Patch{
 			Op:    xjson.NewPatchOp(patch.Operator),
 			Path:  patch.Path,
+			From:  patch.From,
 			Value: tdtl.New(patch.Value),
 		})
 	}
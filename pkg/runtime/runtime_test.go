@@ -17,8 +17,13 @@ limitations under the License.
 package runtime
 
 import (
+	"context"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/runtime/mock"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
 	"github.com/tkeel-io/tdtl"
 )
 
@@ -113,3 +118,55 @@ func Test_adjustTSData(t *testing.T) {
 	out = adjustTSData(in)
 	t.Log(string(out))
 }
+
+func TestRuntime_HandleEventHops(t *testing.T) {
+	rt, _ := newTestRuntime(mock.NewDispatcher(), 2)
+
+	newEvent := func(eid, ttl string) v1.Event {
+		return &v1.ProtoEvent{
+			Id: "ev-" + eid,
+			Metadata: map[string]string{
+				v1.MetaTTL:      ttl,
+				v1.MetaType:     string(v1.ETEntity),
+				v1.MetaEntityID: eid},
+			Data: &v1.ProtoEvent_Patches{Patches: &v1.PatchDatas{
+				Patches: []*v1.PatchData{{
+					Path:     "properties.temp",
+					Operator: xjson.OpReplace.String(),
+					Value:    []byte("25")}}}},
+		}
+	}
+
+	assert.Nil(t, rt.HandleEvent(context.Background(), newEvent("device123", "2")))
+	assert.True(t, rt.entities.Has("device123"))
+
+	// hops exceeded, dropped and counted.
+	assert.Nil(t, rt.HandleEvent(context.Background(), newEvent("device234", "3")))
+	assert.Nil(t, rt.HandleEvent(context.Background(), newEvent("device234", "4")))
+	assert.False(t, rt.entities.Has("device234"))
+	assert.Equal(t, int64(2), rt.Dropped())
+}
+
+func TestRuntime_HandleBatchEvent(t *testing.T) {
+	dispatcher := &eventRecorder{}
+	rt, _ := newTestRuntime(dispatcher, 0)
+
+	setTemp := &v1.PatchData{Operator: "replace", Path: "properties.temp", Value: []byte("25")}
+	assert.Nil(t, rt.HandleEvent(context.Background(), &v1.ProtoEvent{
+		Id: "ev-batch",
+		Metadata: map[string]string{
+			v1.MetaType:     string(v1.ETBatch),
+			v1.MetaEntityID: "device123"},
+		Data: &v1.ProtoEvent_Batch{Batch: &v1.ProtoEvents{Events: []*v1.ProtoEvent{
+			txEvent("device123", "", "", setTemp),
+			txEvent("device234", "", "", setTemp)}}},
+	}))
+
+	// items handled, each responded to its caller.
+	assert.True(t, rt.entities.Has("device123"))
+	assert.True(t, rt.entities.Has("device234"))
+	assert.Len(t, dispatcher.events, 2)
+	for _, ev := range dispatcher.events {
+		assert.Equal(t, v1.ETCallback, ev.Type())
+	}
+}
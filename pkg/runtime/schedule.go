@@ -0,0 +1,302 @@
+package runtime
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/core/pkg/util"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+	"go.uber.org/zap"
+)
+
+const scheduleTick = time.Second
+
+// periodJob publish snapshot of PERIOD subscription.
+type periodJob struct {
+	id       string
+	interval time.Duration
+	next     time.Time
+}
+
+// Schedule deliver ticks to the runtime until stopped, periodic jobs fired on ticks.
+func (r *Runtime) Schedule() {
+	ticker := time.NewTicker(scheduleTick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case now := <-ticker.C:
+			r.deliverTick(r.ctx, now)
+		}
+	}
+}
+
+func (r *Runtime) restoreSchedules(ctx context.Context) error {
+	schedules, err := r.repository.ListSchedule(ctx)
+	if nil != err {
+		return errors.Wrap(err, "restore schedules")
+	}
+
+	for _, s := range schedules {
+		r.addSchedule(ctx, s.ID, s.Interval, false)
+	}
+	return nil
+}
+
+// reloadSchedules sync periodic jobs registered by other runtimes, jobs placed here changed after rebalance.
+func (r *Runtime) reloadSchedules(ctx context.Context) error {
+	schedules, err := r.repository.ListSchedule(ctx)
+	if nil != err {
+		return errors.Wrap(err, "reload schedules")
+	}
+
+	ids := make(map[string]struct{}, len(schedules))
+	for _, s := range schedules {
+		ids[s.ID] = struct{}{}
+		r.addSchedule(ctx, s.ID, s.Interval, false)
+	}
+
+	var removed []string
+	r.slock.Lock()
+	for id := range r.schedules {
+		if _, has := ids[id]; !has {
+			removed = append(removed, id)
+		}
+	}
+	r.slock.Unlock()
+
+	for _, id := range removed {
+		r.removeSchedule(ctx, id, false)
+	}
+	return nil
+}
+
+// addSchedule register periodic job, persist if the runtime owns the job.
+func (r *Runtime) addSchedule(ctx context.Context, id string, interval int64, persist bool) {
+	duration := time.Duration(interval) * time.Second
+	r.slock.Lock()
+	if job, exists := r.schedules[id]; exists && job.interval == duration {
+		r.slock.Unlock()
+		return
+	}
+	r.schedules[id] = &periodJob{id: id, interval: duration, next: time.Now().Add(duration)}
+	r.slock.Unlock()
+
+	log.L().Info("add schedule", zfield.ID(r.id), zfield.Eid(id), zap.Int64("interval", interval))
+	if persist {
+		if err := r.repository.PutSchedule(ctx, &dao.Schedule{ID: id, Interval: interval}); nil != err {
+			log.L().Error("persist schedule", zap.Error(err), zfield.ID(r.id), zfield.Eid(id))
+		}
+	}
+}
+
+func (r *Runtime) removeSchedule(ctx context.Context, id string, persist bool) {
+	r.slock.Lock()
+	_, exists := r.schedules[id]
+	delete(r.schedules, id)
+	r.slock.Unlock()
+
+	if !exists {
+		return
+	}
+
+	log.L().Info("remove schedule", zfield.ID(r.id), zfield.Eid(id))
+	if persist {
+		if err := r.repository.DelSchedule(ctx, &dao.Schedule{ID: id}); nil != err {
+			log.L().Error("remove schedule", zap.Error(err), zfield.ID(r.id), zfield.Eid(id))
+		}
+	}
+}
+
+func (r *Runtime) fireSchedules(ctx context.Context, now time.Time) {
+	var dues []string
+	r.slock.Lock()
+	for id, job := range r.schedules {
+		if now.Before(job.next) {
+			continue
+		}
+		job.next = now.Add(job.interval)
+		// every runtime knows all jobs, fire jobs which placed here.
+		if placement.Global().Select(id).ID == r.id {
+			dues = append(dues, id)
+		}
+	}
+	r.slock.Unlock()
+
+	for _, id := range dues {
+		if err := r.publishPeriod(ctx, id); nil != err {
+			log.L().Error("publish period subscription", zap.Error(err), zfield.ID(r.id), zfield.Eid(id))
+		}
+	}
+}
+
+func (r *Runtime) publishPeriod(ctx context.Context, subID string) error {
+	sub, err := r.LoadEntity(subID)
+	if nil != err {
+		if errors.Is(err, xerrors.ErrEntityNotFound) {
+			r.removeSchedule(ctx, subID, true)
+		}
+		return errors.Wrap(err, "publish period")
+	} else if sub.Type() != dao.EntityTypeSubscription ||
+		sub.GetProp("mode").String() != SModePeriod.S() {
+		r.removeSchedule(ctx, subID, true)
+		return nil
+	}
+
+	payloads, err := r.periodPayloads(ctx, sub)
+	if nil != err {
+		return errors.Wrap(err, "publish period")
+	}
+
+	topic := sub.GetProp("topic").String()
+	pubsubName := sub.GetProp("pubsub_name").String()
+	for _, payload := range payloads {
+		log.L().Debug("publish message", zfield.ID(subID), zfield.Payload(payload),
+			zfield.Topic(topic), zfield.Pubsub(pubsubName), zfield.Mode(SModePeriod.S()))
+		if err = publish(ctx, pubsubName, topic, payload); nil != err {
+			return errors.Wrap(err, "publish period")
+		}
+	}
+
+	return nil
+}
+
+// periodPayloads make snapshot payload for each source entity of the subscription.
+func (r *Runtime) periodPayloads(ctx context.Context, sub Entity) ([][]byte, error) {
+	tql, err := tdtl.NewTDTL(sub.GetProp("filter").String(), nil)
+	if nil != err {
+		return nil, errors.Wrap(err, "parse subscription filter")
+	}
+
+	var payloads [][]byte
+	for eid, keys := range tql.Entities() {
+		if eid == sub.ID() {
+			continue
+		}
+
+		// source entities cached by subscription events.
+		en, err := r.enCache.Load(ctx, eid)
+		if nil != err {
+			log.L().Warn("load cache entity", zap.Error(err), zfield.Eid(eid))
+			continue
+		}
+
+		var patches []Patch
+		for _, key := range keys {
+			path := strings.TrimPrefix(key, eid+".")
+			if path == "*" {
+				patches = append(patches, Patch{Op: xjson.OpReplace,
+					Path: FieldProperties, Value: tdtl.New(en.Properties().Raw())})
+				continue
+			}
+
+			if val := en.Get(path); val.Type() != tdtl.Null && val.Type() != tdtl.Undefined {
+				patches = append(patches, Patch{Op: xjson.OpReplace, Path: path, Value: tdtl.New(val.Raw())})
+			}
+		}
+
+		ev := &v1.ProtoEvent{Metadata: map[string]string{
+			v1.MetaEntityID:   sub.ID(),
+			v1.MetaSender:     eid,
+			v1.MetaOwner:      en.Owner(),
+			v1.MetaSource:     en.Source(),
+			v1.MetaEntityType: en.Type(),
+		}}
+
+		payload, err := makePayload(ev, patches, nil)
+		if nil != err {
+			return nil, errors.Wrap(err, "make payload")
+		}
+		payloads = append(payloads, payload)
+	}
+
+	return payloads, nil
+}
+
+// handleSchedule maintain periodic jobs of subscriptions.
+func (r *Runtime) handleSchedule(ctx context.Context, feed *Feed) *Feed {
+	if ev, ok := feed.Event.(v1.SystemEvent); ok &&
+		ev.Action().GetOperator() == string(v1.OpDelete) {
+		r.removeSchedule(ctx, feed.EntityID, true)
+		return feed
+	}
+
+	// handled for every entity, state parsed once and non-subscriptions skipped early.
+	state := tdtl.New(feed.State)
+	if state.Get(FieldType).String() != dao.EntityTypeSubscription {
+		return feed
+	}
+
+	props := state.Get(FieldProperties)
+	interval, _ := strconv.ParseInt(props.Get("interval").String(), 10, 64)
+	if props.Get("mode").String() == SModePeriod.S() && interval > 0 {
+		r.addSchedule(ctx, feed.EntityID, interval, true)
+	} else {
+		r.removeSchedule(ctx, feed.EntityID, true)
+	}
+
+	return feed
+}
+
+// deliverTick deliver timer event to the runtime, handled in order with events consumed.
+func (r *Runtime) deliverTick(ctx context.Context, now time.Time) {
+	ev := &v1.ProtoEvent{
+		Id:        util.IG().EvID(),
+		Timestamp: now.UnixNano(),
+		Metadata: map[string]string{
+			v1.MetaType:     string(v1.ETSystem),
+			v1.MetaEntityID: r.id},
+		Data: &v1.ProtoEvent_SystemData{
+			SystemData: &v1.SystemData{
+				Operator: string(v1.OpTick),
+			}},
+	}
+
+	r.elock.Lock()
+	defer r.elock.Unlock()
+	r.HandleEvent(ctx, ev)
+}
+
+func isTick(ev v1.Event) bool {
+	sev, ok := ev.(v1.SystemEvent)
+	return ok && ev.Type() == v1.ETSystem &&
+		sev.Action().GetOperator() == string(v1.OpTick)
+}
+
+// handleTick fire periodic jobs, close tumbling windows and capture cache snapshot at the time of tick.
+func (r *Runtime) handleTick(ctx context.Context, ev v1.Event) {
+	now := eventTime(ev)
+	r.fireSchedules(ctx, now)
+	r.closeWindows(ctx, now)
+	r.captureSnapshot(now)
+}
+
+// captureSnapshot capture cache within the event loop, persisted by the snapshot goroutine.
+func (r *Runtime) captureSnapshot(now time.Time) {
+	if r.snapshotEvery <= 0 {
+		return
+	} else if r.nextSnapshot.IsZero() {
+		r.nextSnapshot = now.Add(r.snapshotEvery)
+		return
+	} else if now.Before(r.nextSnapshot) {
+		return
+	}
+
+	r.nextSnapshot = now.Add(r.snapshotEvery)
+	select {
+	case r.snapshots <- r.enCache.Capture():
+	default:
+		log.L().Warn("snapshot runtime cache, the last snapshot in progress", zfield.ID(r.id))
+	}
+}
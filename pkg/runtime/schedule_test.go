@@ -0,0 +1,66 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/core/pkg/runtime/mock"
+	"github.com/tkeel-io/tdtl"
+)
+
+func TestRuntime_PeriodSubscription(t *testing.T) {
+	rt, _ := newTestRuntime(mock.NewDispatcher(), 0)
+
+	sub, err := NewEntity("sub123", []byte(`{"id":"sub123","type":"SUBSCRIPTION","owner":"admin","source":"dm",
+		"properties":{"mode":"PERIOD","interval":10,"topic":"sub123","pubsub_name":"pubsub",
+		"filter":"insert into sub123 select device123.*, device234.properties.temp"}}`))
+	assert.Nil(t, err)
+
+	// register schedule.
+	rt.handleSchedule(context.Background(), &Feed{EntityID: sub.ID(), State: sub.Raw(), Event: &v1.ProtoEvent{}})
+	assert.Equal(t, 10*time.Second, rt.schedules["sub123"].interval)
+
+	// snapshot payloads.
+	cache, _ := rt.enCache.(*eCache)
+	cache.entities["device123"], _ = NewEntity("device123", []byte(`{"id":"device123","type":"DEVICE","owner":"admin","properties":{"temp":20,"metrics":{"cpu":0.7}}}`))
+	cache.entities["device234"], _ = NewEntity("device234", []byte(`{"id":"device234","type":"DEVICE","owner":"admin","properties":{"temp":30,"hum":50}}`))
+	payloads, err := rt.periodPayloads(context.Background(), sub)
+	assert.Nil(t, err)
+	assert.Len(t, payloads, 2)
+	for _, payload := range payloads {
+		cc := tdtl.New(payload)
+		assert.Equal(t, "sub123", cc.Get("subscribe_id").String())
+		switch cc.Get("id").String() {
+		case "device123":
+			assert.Equal(t, "0.7", cc.Get("properties.metrics.cpu").String())
+		case "device234":
+			assert.Equal(t, "30", cc.Get("properties.temp").String())
+			assert.NotContains(t, string(payload), "hum")
+		default:
+			t.Fatalf("unexpected payload %s", string(payload))
+		}
+	}
+
+	// remove schedule when subscription deleted.
+	ev := &v1.ProtoEvent{Metadata: map[string]string{}, Data: &v1.ProtoEvent_SystemData{
+		SystemData: &v1.SystemData{Operator: string(v1.OpDelete)}}}
+	rt.handleSchedule(context.Background(), &Feed{EntityID: sub.ID(), Event: ev})
+	assert.NotContains(t, rt.schedules, "sub123")
+}
+
+func TestRuntime_ReloadSchedules(t *testing.T) {
+	rt, repo := newTestRuntime(mock.NewDispatcher(), 0)
+	rt.addSchedule(context.Background(), "sub123", 10, true)
+	rt.addSchedule(context.Background(), "sub234", 10, false)
+
+	// registered by other runtime.
+	repo.Schedules["sub345"] = dao.Schedule{ID: "sub345", Interval: 5}
+	assert.Nil(t, rt.reloadSchedules(context.Background()))
+	assert.Equal(t, 5*time.Second, rt.schedules["sub345"].interval)
+	assert.Contains(t, rt.schedules, "sub123")
+	assert.NotContains(t, rt.schedules, "sub234")
+}
@@ -24,6 +24,35 @@ type Feed struct {
 	EntityID string
 	Patches  []Patch
 	Changes  []Patch
+
+	// stageErr error of required custom post stage, custom stages after it skipped.
+	stageErr error
+	// jobPending apply result of job reported by the event dispatched then.
+	jobPending bool
+}
+
+// clone returns copy of feed, patches and changes not shared.
+func (f *Feed) clone() *Feed {
+	out := *f
+	out.State = append([]byte(nil), f.State...)
+	out.Patches = clonePatches(f.Patches)
+	out.Changes = clonePatches(f.Changes)
+	return &out
+}
+
+func clonePatches(patches []Patch) []Patch {
+	if nil == patches {
+		return nil
+	}
+
+	out := make([]Patch, len(patches))
+	for index, patch := range patches {
+		out[index] = patch
+		if nil != patch.Value {
+			out[index].Value = patch.Value.Copy()
+		}
+	}
+	return out
 }
 
 // The *Funcs functions are executed in the following order:
@@ -28,6 +28,9 @@ const (
 	SModeOnChanged SubscriptionMode = "ONCHANGED"
 )
 
+// FieldOldProperties previous values of changed properties, ONCHANGED only.
+const FieldOldProperties = "old_properties"
+
 // 为了订阅实体实现的外部订阅.
 func (r *Runtime) handleSubscribe(ctx context.Context, feed *Feed) *Feed {
 	log.L().Debug("handle subscribe", zfield.Eid(feed.EntityID), zfield.Event(feed.Event))
@@ -36,6 +39,8 @@ func (r *Runtime) handleSubscribe(ctx context.Context, feed *Feed) *Feed {
 	var err error
 	subID := ev.Entity()
 	entityID := ev.Attr(v1.MetaSender)
+	// diff against state of sender before the change, shared by targets of it.
+	diffs, diffOlds := r.diffSender(ev, feed.State, feed.Patches)
 	state, err := r.LoadEntity(subID)
 	if nil != err {
 		log.L().Error("load entity", zap.Error(err), zfield.Eid(subID))
@@ -58,8 +63,17 @@ func (r *Runtime) handleSubscribe(ctx context.Context, feed *Feed) *Feed {
 			return feed
 		}
 
+		var olds []Patch
+		if mode == SModeOnChanged.S() {
+			if changes, olds = diffs, diffOlds; len(changes) == 0 {
+				log.L().Debug("publish message, nothing changed", zfield.ID(subID), zfield.Event(ev),
+					zfield.Eid(entityID), zfield.Topic(topic), zfield.Pubsub(pubsubName), zfield.Mode(mode))
+				return feed
+			}
+		}
+
 		var payload []byte
-		if payload, err = makePayload(ev, changes); nil != err {
+		if payload, err = makePayload(ev, changes, olds); nil != err {
 			log.L().Error("publish message, make payload", zfield.ID(subID), zfield.Event(ev),
 				zfield.Eid(entityID), zfield.Topic(topic), zfield.Pubsub(pubsubName), zfield.Mode(mode))
 			return feed
@@ -69,17 +83,14 @@ func (r *Runtime) handleSubscribe(ctx context.Context, feed *Feed) *Feed {
 			zfield.Eid(entityID), zfield.Topic(topic), zfield.Pubsub(pubsubName), zfield.Mode(mode))
 
 		switch mode {
-		case SModeRealtime.S():
-			ctOpts := daprSDK.PublishEventWithContentType("application/json")
-			err = dapr.Get().Select().PublishEvent(ctx, pubsubName, topic, payload, ctOpts)
-			if nil != err {
+		case SModeRealtime.S(), SModeOnChanged.S():
+			if err = publish(ctx, pubsubName, topic, payload); nil != err {
 				log.L().Error("publish message via dapr", zfield.ID(subID), zfield.Event(ev),
 					zfield.Eid(entityID), zfield.Topic(topic), zfield.Pubsub(pubsubName), zfield.Mode(mode))
 				return feed
 			}
-		case SModeOnChanged.S():
-		//	dapr.Get().Select().PublishEvent(ctx, pubsubName, topic, nil)
 		case SModePeriod.S():
+			// published by scheduler, see Runtime.Schedule.
 		default:
 		}
 	default:
@@ -89,7 +100,86 @@ func (r *Runtime) handleSubscribe(ctx context.Context, feed *Feed) *Feed {
 	return feed
 }
 
-func makePayload(ev v1.PatchEvent, changes []Patch) ([]byte, error) {
+func publish(ctx context.Context, pubsubName, topic string, payload []byte) error {
+	ctOpts := daprSDK.PublishEventWithContentType("application/json")
+	err := dapr.Get().Select().PublishEvent(ctx, pubsubName, topic, payload, ctOpts)
+	return errors.Wrap(err, "publish message via dapr")
+}
+
+// senderBase cached state of sender before change of version applied.
+type senderBase struct {
+	version string
+	state   []byte
+}
+
+// diffSender diff patches against cached state of sender before the change, events of the same
+// change fan out to targets with the version of sender, cached state patched by the first one.
+func (r *Runtime) diffSender(ev v1.Event, cached []byte, patches []Patch) (changes, olds []Patch) {
+	sender, version := ev.Attr(v1.MetaSender), ev.Attr(v1.MetaVersion)
+	r.slock.Lock()
+	base, has := r.senderBases[sender]
+	if !has || version == "" || base.version != version {
+		base = &senderBase{version: version, state: cached}
+		r.senderBases[sender] = base
+	}
+	r.slock.Unlock()
+
+	return diffPatches(tdtl.New(base.state), patches)
+}
+
+// diffPatches returns patches which actually change the state, and the previous values.
+func diffPatches(state *tdtl.Collect, patches []Patch) (changes, olds []Patch) {
+	// expand merge patches, compare property by property.
+	var expanded []Patch
+	for _, patch := range patches {
+		if xjson.OpMerge != patch.Op {
+			expanded = append(expanded, patch)
+			continue
+		}
+		patch.Value.Foreach(func(key []byte, value *tdtl.Collect) {
+			expanded = append(expanded, Patch{Op: xjson.OpReplace,
+				Path: patch.Path + "." + string(key), Value: value})
+		})
+	}
+
+	cc := state.Copy()
+	for _, patch := range expanded {
+		oldVal := cc.Get(patch.Path)
+		switch patch.Op {
+		case xjson.OpReplace:
+			if equalNode(oldVal, patch.Value) {
+				continue
+			}
+			cc.Set(patch.Path, patch.Value)
+		case xjson.OpRemove:
+			if !existNode(oldVal) {
+				continue
+			}
+			cc.Del(patch.Path)
+			patch = Patch{Op: xjson.OpReplace, Path: patch.Path, Value: tdtl.New([]byte("null"))}
+		case xjson.OpAdd:
+			cc.Append(patch.Path, patch.Value)
+		default:
+			continue
+		}
+
+		prev := tdtl.New([]byte("null"))
+		if existNode(oldVal) {
+			prev = tdtl.New(oldVal.Raw())
+		}
+		changes = append(changes, patch)
+		olds = append(olds, Patch{Op: xjson.OpReplace, Path: patch.Path, Value: prev})
+	}
+
+	return changes, olds
+}
+
+func existNode(node tdtl.Node) bool {
+	return nil == node.Error() && tdtl.Null != node.Type() && tdtl.Undefined != node.Type()
+}
+
+// makePayload make subscription message, olds are previous values of changes, optional.
+func makePayload(ev v1.PatchEvent, changes []Patch, olds []Patch) ([]byte, error) {
 	basics := map[string]string{
 		"id":           ev.Attr(v1.MetaSender),
 		"subscribe_id": ev.Entity(),
@@ -99,8 +189,25 @@ func makePayload(ev v1.PatchEvent, changes []Patch) ([]byte, error) {
 	}
 	bytes, _ := json.Marshal(basics)
 
+	cc, err := patchProperties(changes)
+	if nil != err {
+		return nil, err
+	}
+
+	payload := tdtl.New(bytes)
+	payload.Set(FieldProperties, cc.Get(FieldProperties))
+	if len(olds) > 0 {
+		if cc, err = patchProperties(olds); nil != err {
+			return nil, err
+		}
+		payload.Set(FieldOldProperties, cc.Get(FieldProperties))
+	}
+	return payload.Raw(), payload.Error()
+}
+
+func patchProperties(patches []Patch) (*tdtl.Collect, error) {
 	cc := tdtl.New(`{"properties":{}}`)
-	for _, change := range changes {
+	for _, change := range patches {
 		switch change.Op {
 		case xjson.OpAdd:
 			cc.Append(change.Path, change.Value)
@@ -117,8 +224,5 @@ func makePayload(ev v1.PatchEvent, changes []Patch) ([]byte, error) {
 			return nil, errors.Wrap(cc.Error(), "patch json")
 		}
 	}
-
-	payload := tdtl.New(bytes)
-	payload.Set(FieldProperties, cc.Get(FieldProperties))
-	return payload.Raw(), payload.Error()
+	return cc, nil
 }
@@ -37,8 +37,68 @@ func Test_makePayload(t *testing.T) {
 			Path:  "properties.metrics.cpu.value",
 			Value: tdtl.New(`0.78`),
 		},
-	})
+	}, nil)
 
 	assert.Nil(t, err)
 	t.Log("payload: ", string(bytes))
 }
+
+func Test_diffPatches(t *testing.T) {
+	ev := &v1.ProtoEvent{
+		Metadata: map[string]string{
+			v1.MetaEntityID:   "sub123",
+			v1.MetaSender:     "device123",
+			v1.MetaEntityType: "DEVICE",
+		},
+	}
+
+	state := tdtl.New(`{"id":"device123","properties":{"temp":20,"hum":50,"metrics":{"cpu":0.7,"mem":0.3}}}`)
+	changes, olds := diffPatches(state, []Patch{
+		{Op: xjson.OpReplace, Path: "properties.temp", Value: tdtl.New(`20`)},
+		{Op: xjson.OpReplace, Path: "properties.hum", Value: tdtl.New(`60`)},
+		{Op: xjson.OpMerge, Path: "properties.metrics", Value: tdtl.New(`{"cpu":0.7,"mem":0.5}`)},
+		{Op: xjson.OpReplace, Path: "properties.light", Value: tdtl.New(`true`)},
+	})
+	assert.Len(t, changes, 3)
+	assert.Len(t, olds, 3)
+
+	bytes, err := makePayload(ev, changes, olds)
+	assert.Nil(t, err)
+	payload := tdtl.New(bytes)
+	assert.Equal(t, "60", payload.Get("properties.hum").String())
+	assert.Equal(t, "50", payload.Get("old_properties.hum").String())
+	assert.Equal(t, "0.5", payload.Get("properties.metrics.mem").String())
+	assert.Equal(t, "0.3", payload.Get("old_properties.metrics.mem").String())
+	assert.Equal(t, "true", payload.Get("properties.light").String())
+	assert.Equal(t, "null", string(payload.Get("old_properties.light").Raw()))
+	assert.NotContains(t, string(payload.Get("properties").Raw()), "temp")
+	assert.NotContains(t, string(payload.Get("properties").Raw()), "cpu")
+
+	// nothing changed.
+	changes, _ = diffPatches(state, []Patch{
+		{Op: xjson.OpReplace, Path: "properties.temp", Value: tdtl.New(`20`)},
+	})
+	assert.Len(t, changes, 0)
+}
+
+func TestRuntime_DiffSender(t *testing.T) {
+	rt, _ := newTestRuntime(nil, 0)
+	patches := []Patch{{Op: xjson.OpReplace, Path: "properties.temp", Value: tdtl.New(`25`)}}
+	newEvent := func(sub, version string) v1.Event {
+		return &v1.ProtoEvent{Metadata: map[string]string{
+			v1.MetaEntityID: sub, v1.MetaSender: "device123", v1.MetaVersion: version}}
+	}
+
+	changes, olds := rt.diffSender(newEvent("sub1", "2"), []byte(`{"properties":{"temp":20}}`), patches)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, "20", olds[0].Value.String())
+
+	// cached state patched by the event of sub1, diff against state before the change.
+	changes, olds = rt.diffSender(newEvent("sub2", "2"), []byte(`{"properties":{"temp":25}}`), append(patches,
+		Patch{Op: xjson.OpReplace, Path: "properties.hum", Value: tdtl.New(`40`)}))
+	assert.Len(t, changes, 2)
+	assert.Equal(t, "20", olds[0].Value.String())
+
+	changes, _ = rt.diffSender(newEvent("sub1", "3"), []byte(`{"properties":{"temp":25}}`), patches)
+	assert.Len(t, changes, 0)
+}
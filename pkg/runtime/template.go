@@ -0,0 +1,273 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/core/pkg/scheme"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+	"go.uber.org/zap"
+)
+
+// templatesOf returns ordered parent templates, fallback to template_id.
+func templatesOf(state *tdtl.Collect) []string {
+	var templates []string
+	if raw := state.Get(FieldTemplates); tdtl.Array == raw.Type() {
+		var ids []string
+		if err := json.Unmarshal(raw.Raw(), &ids); nil != err {
+			log.L().Warn("decode templates", zap.Error(err), zfield.Value(string(raw.Raw())))
+		}
+		for _, id := range ids {
+			if id = strings.TrimSpace(id); id != "" {
+				templates = append(templates, id)
+			}
+		}
+	}
+
+	if len(templates) == 0 {
+		if raw := state.Get(FieldTemplate); tdtl.String == raw.Type() {
+			if id := strings.TrimSpace(raw.String()); id != "" {
+				templates = append(templates, id)
+			}
+		}
+	}
+	return templates
+}
+
+// inheritNode returns scheme inheritance declaration of entity.
+func inheritNode(id string, state *tdtl.Collect) (*scheme.InheritNode, error) {
+	node := &scheme.InheritNode{
+		ID:        id,
+		Templates: templatesOf(state),
+		Scheme:    make(map[string]json.RawMessage),
+	}
+
+	if raw := state.Get(FieldScheme); tdtl.Object == raw.Type() {
+		if err := json.Unmarshal(raw.Raw(), &node.Scheme); nil != err {
+			return nil, errors.Wrap(err, "decode scheme")
+		}
+	}
+
+	// entities created before inheritance supported have no origins.
+	if raw := state.Get(FieldSchemeOrigins); tdtl.Object == raw.Type() {
+		if err := json.Unmarshal(raw.Raw(), &node.Origins); nil != err {
+			return nil, errors.Wrap(err, "decode scheme origins")
+		}
+	}
+
+	return node, nil
+}
+
+func (r *Runtime) loadInheritNode(id string) (*scheme.InheritNode, error) {
+	// templates may be updated by other runtimes.
+	r.entities.Invalidate(id)
+	ten, err := r.LoadEntity(id)
+	if nil != err {
+		log.L().Error("load template", zap.Error(err), zfield.Template(id))
+		if errors.Is(err, xerrors.ErrEntityNotFound) {
+			return nil, fmt.Errorf("%w: %s", xerrors.ErrTemplateNotFound, id)
+		}
+		return nil, errors.Wrap(err, "load template")
+	}
+
+	return inheritNode(id, tdtl.New(ten.Raw()))
+}
+
+// resolveScheme returns effective scheme of entity and scheme mode inherited from templates.
+func (r *Runtime) resolveScheme(node *scheme.InheritNode) (*scheme.Resolved, string, error) {
+	resolved, err := scheme.Resolve(node, r.loadInheritNode)
+	if nil != err {
+		return nil, "", errors.Wrap(err, "resolve scheme")
+	}
+
+	// the former template takes precedence.
+	for _, tid := range node.Templates {
+		if ten, err := r.LoadEntity(tid); nil == err {
+			if mode := schemeMode(ten); mode != "" {
+				return resolved, mode, nil
+			}
+		}
+	}
+
+	return resolved, "", nil
+}
+
+// inheritPatches returns patches which apply resolved scheme to entity, empty if scheme unchanged.
+func inheritPatches(state *tdtl.Collect, eid string, resolved *scheme.Resolved, mode string) ([]Patch, error) {
+	bytes, err := json.Marshal(resolved.Scheme)
+	if nil != err {
+		return nil, errors.Wrap(err, "encode scheme")
+	}
+
+	origins, err := json.Marshal(resolved.Inherited(eid))
+	if nil != err {
+		return nil, errors.Wrap(err, "encode scheme origins")
+	}
+
+	// scheme and origins equal to current state not patched.
+	var patches []Patch
+	for _, patch := range []Patch{
+		{Op: xjson.OpReplace, Path: FieldScheme, Value: tdtl.New(bytes)},
+		{Op: xjson.OpReplace, Path: FieldSchemeOrigins, Value: tdtl.New(origins)},
+	} {
+		if !equalNode(state.Get(patch.Path), patch.Value) {
+			patches = append(patches, patch)
+		}
+	}
+
+	// inherit scheme mode of templates if entity not specified.
+	if mode != "" && tdtl.String != state.Get(FieldSchemeMode).Type() {
+		patches = append(patches, Patch{
+			Op:    xjson.OpReplace,
+			Path:  FieldSchemeMode,
+			Value: tdtl.New(tdtl.NewString(mode).Raw())})
+	}
+
+	return patches, nil
+}
+
+// checkTemplates reject patches which reference missing templates or make cycles.
+func (r *Runtime) checkTemplates(en Entity, patches []Patch) error {
+	var changed bool
+	state := tdtl.New(en.Raw())
+	for _, patch := range patches {
+		if FieldTemplate != patch.Path && FieldTemplates != patch.Path {
+			continue
+		}
+
+		changed = true
+		switch patch.Op {
+		case xjson.OpRemove:
+			state.Del(patch.Path)
+		case xjson.OpAdd:
+			state.Append(patch.Path, patch.Value)
+		default:
+			state.Set(patch.Path, patch.Value)
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	_, err := scheme.Resolve(&scheme.InheritNode{
+		ID: en.ID(), Templates: templatesOf(state)}, r.loadInheritNode)
+	return err
+}
+
+// markLocalScheme scheme entries written directly are local, which override inherited entries.
+func markLocalScheme(state *tdtl.Collect, changes []Patch) {
+	for _, change := range changes {
+		if FieldSchemeOrigins == change.Path ||
+			strings.HasPrefix(change.Path, FieldSchemeOrigins+".") {
+			// origins maintained by inheritance.
+			return
+		}
+	}
+
+	for _, change := range changes {
+		switch {
+		case FieldScheme == change.Path:
+			state.Set(FieldSchemeOrigins, tdtl.New([]byte("{}")))
+		case strings.HasPrefix(change.Path, FieldScheme+"."):
+			segs := strings.SplitN(strings.TrimPrefix(change.Path, FieldScheme+"."), ".", 2)
+			if path := FieldSchemeOrigins + "." + segs[0]; existNode(state.Get(path)) {
+				state.Del(path)
+			}
+		}
+	}
+}
+
+func (r *Runtime) handleTemplate(ctx context.Context, feed *Feed) *Feed {
+	log.L().Debug("handle template", zfield.Eid(feed.EntityID))
+	if tid := feed.Event.Attr(v1.MetaTemplateID); tid != "" {
+		if nil == feed.Err {
+			feed.Err = r.onTemplatePropagated(ctx, feed, tid)
+		}
+		return feed
+	}
+
+	for index := range feed.Changes {
+		if FieldTemplate == feed.Changes[index].Path ||
+			FieldTemplates == feed.Changes[index].Path {
+			log.Info("entity template changed", zfield.Eid(feed.EntityID),
+				zfield.Template(feed.Changes[index].Value.String()))
+			_, feed.Err = r.onTemplateChanged(ctx, feed.EntityID, "")
+			break
+		}
+	}
+	return feed
+}
+
+// onTemplateChanged dispatch scheme inherited to the entity, returns true if dispatched,
+// the event carries job id if triggered by the job.
+func (r *Runtime) onTemplateChanged(ctx context.Context, entityID, jobID string) (bool, error) {
+	log.L().Debug("entity template changed", zfield.Eid(entityID))
+	en, err := r.LoadEntity(entityID)
+	if nil != err {
+		log.L().Error("onTemplateChanged", zap.Error(err), zfield.Eid(entityID))
+		return false, errors.Wrap(err, "On Template Changed")
+	}
+
+	state := tdtl.New(en.Raw())
+	node, err := inheritNode(entityID, state)
+	if nil != err {
+		return false, errors.Wrap(err, "On Template Changed")
+	}
+
+	resolved, mode, err := r.resolveScheme(node)
+	if nil != err {
+		log.L().Error("onTemplateChanged", zap.Error(err),
+			zfield.Eid(entityID), zap.Strings("templates", node.Templates))
+		return false, errors.Wrap(err, "On Template Changed")
+	}
+
+	// 为什么使用dispatch 异步更新scheme， 而不是直接更新？
+	// 1. 将 templateID 更新 scheme 更新分离，降低 api调用时延.
+	// 2.
+
+	patches, err := inheritPatches(state, entityID, resolved, mode)
+	if nil != err {
+		return false, errors.Wrap(err, "On Template Changed")
+	}
+
+	if len(patches) == 0 {
+		// scheme unchanged.
+		return false, nil
+	}
+
+	pds := make([]*v1.PatchData, 0, len(patches))
+	for _, patch := range patches {
+		pds = append(pds, &v1.PatchData{
+			Path:     patch.Path,
+			Value:    patch.Value.Raw(),
+			Operator: patch.Op.String()})
+	}
+
+	ev := &v1.ProtoEvent{
+		Id:        entityID,
+		Timestamp: time.Now().UnixNano(),
+		Metadata: map[string]string{
+			v1.MetaType:     string(v1.ETEntity),
+			v1.MetaEntityID: entityID,
+			v1.MetaSender:   entityID},
+		Data: &v1.ProtoEvent_Patches{
+			Patches: &v1.PatchDatas{
+				Patches: pds}}}
+	if jobID != "" {
+		ev.Metadata[v1.MetaJobID] = jobID
+	}
+	if err = r.dispatcher.Dispatch(ctx, ev); nil != err {
+		return false, errors.Wrap(err, "On Template Changed")
+	}
+	return true, nil
+}
@@ -0,0 +1,34 @@
+package runtime
+
+import (
+	"time"
+
+	"go.uber.org/atomic"
+)
+
+// throttle allows an action at most once per interval, counting suppressed ones,
+// used to keep logs of frequent events from flooding.
+type throttle struct {
+	interval   time.Duration
+	last       *atomic.Int64
+	suppressed *atomic.Int64
+}
+
+func newThrottle(interval time.Duration) *throttle {
+	return &throttle{
+		interval:   interval,
+		last:       atomic.NewInt64(0),
+		suppressed: atomic.NewInt64(0),
+	}
+}
+
+// Allow returns true with the number of actions suppressed since the last allowed one,
+// if the interval elapsed since then.
+func (t *throttle) Allow(now time.Time) (int64, bool) {
+	last := t.last.Load()
+	if now.UnixNano()-last < int64(t.interval) || !t.last.CAS(last, now.UnixNano()) {
+		t.suppressed.Inc()
+		return 0, false
+	}
+	return t.suppressed.Swap(0), true
+}
@@ -0,0 +1,24 @@
+package runtime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThrottle(t *testing.T) {
+	th := newThrottle(time.Minute)
+	now := time.Now()
+
+	_, ok := th.Allow(now)
+	assert.True(t, ok)
+	_, ok = th.Allow(now.Add(time.Second))
+	assert.False(t, ok)
+	_, ok = th.Allow(now.Add(2 * time.Second))
+	assert.False(t, ok)
+
+	suppressed, ok := th.Allow(now.Add(time.Minute))
+	assert.True(t, ok)
+	assert.Equal(t, int64(2), suppressed)
+}
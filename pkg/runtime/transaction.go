@@ -0,0 +1,311 @@
+package runtime
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/core/pkg/util"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+	"go.uber.org/zap"
+)
+
+// lock timeout of entity prepared, longer than timeout of coordinator.
+const txLockTimeout = 60 * time.Second
+
+// pendingTx transaction prepared by entity, patches staged until committed.
+type pendingTx struct {
+	id       string
+	patches  []Patch
+	deferred []v1.Event
+	timer    *time.Timer
+}
+
+func (p *pendingTx) txLock(rid, eid string) *dao.TxLock {
+	lock := &dao.TxLock{
+		TxID:      p.id,
+		RuntimeID: rid,
+		EntityID:  eid,
+		Patches:   make([]dao.TxPatch, 0, len(p.patches)),
+		Timestamp: time.Now().UnixNano() / 1e6,
+	}
+	for _, patch := range p.patches {
+		lock.Patches = append(lock.Patches, dao.TxPatch{
+			Op:    patch.Op.String(),
+			Path:  patch.Path,
+			From:  patch.From,
+			Value: patch.Value.Raw(),
+		})
+	}
+	return lock
+}
+
+// prepareTxEvent handle phases of transaction:
+//   - prepare: dry run patches, lock entity if succeed.
+//   - commit: apply staged patches, unlock entity.
+//   - abort: discard staged patches, unlock entity.
+//   - resolve: commit or abort by the decision, if locked too long.
+func (r *Runtime) prepareTxEvent(ctx context.Context, ev v1.Event) (*Execer, *Feed) {
+	txID := ev.Attr(v1.MetaTxID)
+	phase := v1.TxPhase(ev.Attr(v1.MetaTxPhase))
+	log.L().Info("handle transaction event", zfield.ID(ev.ID()),
+		zfield.Eid(ev.Entity()), zap.String("tx", txID), zap.String("phase", string(phase)))
+
+	state, err := r.loadEntity(ev.Entity(), phase == v1.TxCommit || phase == v1.TxResolve)
+	if nil != err {
+		log.L().Error("load entity", zfield.Eid(ev.Entity()),
+			zap.Error(err), zfield.ID(ev.ID()), zap.String("tx", txID))
+		state = DefaultEntity(ev.Entity())
+	}
+
+	execer := &Execer{
+		state:    state,
+		execFunc: &handlerImpl{fn: func(_ context.Context, feed *Feed) *Feed { return feed }}}
+	feed := &Feed{
+		Err:      err,
+		Event:    ev,
+		State:    state.Raw(),
+		EntityID: ev.Entity()}
+	if nil != err {
+		return execer, feed
+	}
+
+	if phase == v1.TxResolve {
+		phase = r.resolveTx(ctx, txID, ev.Entity())
+	}
+
+	switch phase {
+	case v1.TxPrepare:
+		feed.Err = r.prepareTx(ctx, state, ev)
+	case v1.TxCommit:
+		pending := r.releaseTx(txID, ev.Entity())
+		if nil == pending {
+			log.L().Warn("commit transaction, not prepared", zfield.Eid(ev.Entity()), zap.String("tx", txID))
+			feed.Err = xerrors.ErrTransactionNotFound
+			return execer, feed
+		}
+
+		// apply staged patches as patching entity.
+		execer = r.entityExecer(state)
+		feed.Patches = pending.patches
+	case v1.TxAbort:
+		r.releaseTx(txID, ev.Entity())
+	case v1.TxResolve:
+		// decision unknown, keep locked.
+	default:
+		feed.Err = xerrors.ErrInvalidRequest
+	}
+
+	return execer, feed
+}
+
+func (r *Runtime) prepareTx(ctx context.Context, state Entity, ev v1.Event) error {
+	r.tlock.Lock()
+	pending, has := r.txs[ev.Entity()]
+	r.tlock.Unlock()
+
+	txID := ev.Attr(v1.MetaTxID)
+	if has {
+		if pending.id == txID {
+			// redelivered.
+			return nil
+		}
+		// no wait, avoid deadlock between transactions.
+		log.L().Warn("prepare transaction, entity locked", zfield.Eid(ev.Entity()),
+			zap.String("tx", txID), zap.String("locked_by", pending.id))
+		return xerrors.ErrEntityLocked
+	}
+
+	e, _ := ev.(v1.PatchEvent)
+	if err := r.checkTemplates(state, conv(e.Patches())); nil != err {
+		return err
+	}
+
+	// dry run on copy, entity unchanged until committed.
+	feed := state.Copy().Handle(ctx, &Feed{
+		Event:    ev,
+		EntityID: ev.Entity(),
+		Patches:  conv(e.Patches())})
+	if nil != feed.Err {
+		log.L().Warn("prepare transaction", zfield.Eid(ev.Entity()),
+			zap.String("tx", txID), zfield.Reason(feed.Err.Error()))
+		return feed.Err
+	}
+
+	// lock survives restarts, resolved by the decision once restored.
+	pending = &pendingTx{id: txID, patches: conv(e.Patches())}
+	if err := r.repository.PutTxLock(ctx, pending.txLock(r.id, ev.Entity())); nil != err {
+		log.L().Error("prepare transaction, put lock", zap.Error(err),
+			zfield.Eid(ev.Entity()), zap.String("tx", txID))
+		return errors.Wrap(err, "prepare transaction")
+	}
+
+	r.tlock.Lock()
+	r.txs[ev.Entity()] = pending
+	r.tlock.Unlock()
+	r.armTx(pending, ev.Entity())
+	return nil
+}
+
+// armTx resolve transaction if entity locked too long, the coordinator may be gone.
+func (r *Runtime) armTx(pending *pendingTx, eid string) {
+	txID := pending.id
+	pending.timer = time.AfterFunc(txLockTimeout, func() {
+		if err := r.dispatcher.Dispatch(r.ctx, &v1.ProtoEvent{
+			Id:        util.IG().EvID(),
+			Timestamp: time.Now().UnixNano(),
+			Metadata: map[string]string{
+				v1.MetaType:     string(v1.ETEntity),
+				v1.MetaEntityID: eid,
+				v1.MetaTxID:     txID,
+				v1.MetaTxPhase:  string(v1.TxResolve)},
+			Data: &v1.ProtoEvent_Patches{
+				Patches: &v1.PatchDatas{}},
+		}); nil != err {
+			log.L().Error("resolve transaction", zap.Error(err), zfield.Eid(eid), zap.String("tx", txID))
+		}
+	})
+}
+
+// resolveTx returns the decision of transaction, abort it if undecided.
+func (r *Runtime) resolveTx(ctx context.Context, txID, eid string) v1.TxPhase {
+	r.tlock.Lock()
+	pending, has := r.txs[eid]
+	r.tlock.Unlock()
+	if !has || pending.id != txID {
+		// completed already.
+		return v1.TxAbort
+	}
+
+	decided, err := r.repository.DecideTransaction(ctx, &dao.Transaction{
+		ID:        txID,
+		Status:    dao.TxAborted,
+		Entities:  []string{eid},
+		Timestamp: time.Now().UnixNano() / 1e6,
+	})
+	if nil != err {
+		log.L().Error("resolve transaction, retry later", zap.Error(err), zfield.Eid(eid), zap.String("tx", txID))
+		r.armTx(pending, eid)
+		return v1.TxResolve
+	}
+
+	log.L().Info("resolve transaction", zfield.Eid(eid),
+		zap.String("tx", txID), zap.String("status", string(decided.Status)))
+	if dao.TxCommitted == decided.Status {
+		return v1.TxCommit
+	}
+	return v1.TxAbort
+}
+
+// releaseTx unlock entity locked by transaction, returns nil if not locked by it.
+func (r *Runtime) releaseTx(txID, eid string) *pendingTx {
+	r.tlock.Lock()
+	defer r.tlock.Unlock()
+	pending, has := r.txs[eid]
+	if !has || pending.id != txID {
+		return nil
+	}
+
+	pending.timer.Stop()
+	delete(r.txs, eid)
+	r.replays = append(r.replays, pending.deferred...)
+	// lock deleted once entity flushed.
+	r.released = append(r.released, &dao.TxLock{TxID: txID, RuntimeID: r.id, EntityID: eid})
+	return pending
+}
+
+// restoreTxs lock entities by transactions prepared before restarted.
+func (r *Runtime) restoreTxs(ctx context.Context) error {
+	locks, err := r.repository.ListTxLock(ctx, r.id)
+	if nil != err {
+		return errors.Wrap(err, "restore transactions")
+	}
+
+	r.tlock.Lock()
+	defer r.tlock.Unlock()
+	for _, lock := range locks {
+		pending := &pendingTx{id: lock.TxID, patches: make([]Patch, 0, len(lock.Patches))}
+		for _, patch := range lock.Patches {
+			pending.patches = append(pending.patches, Patch{
+				Op:    xjson.NewPatchOp(patch.Op),
+				Path:  patch.Path,
+				From:  patch.From,
+				Value: tdtl.New(patch.Value),
+			})
+		}
+		r.txs[lock.EntityID] = pending
+		r.armTx(pending, lock.EntityID)
+	}
+
+	log.L().Info("restore transactions", zfield.ID(r.id), zap.Int("locks", len(locks)))
+	return nil
+}
+
+// flushTxs delete locks of transactions completed, returns error if events deferred,
+// offsets of deferred events committed once applied.
+func (r *Runtime) flushTxs(ctx context.Context) error {
+	r.tlock.Lock()
+	released := r.released
+	r.released = nil
+	r.tlock.Unlock()
+
+	for index, lock := range released {
+		if err := r.repository.DelTxLock(ctx, lock); nil != err {
+			r.tlock.Lock()
+			r.released = append(released[index:], r.released...)
+			r.tlock.Unlock()
+			return errors.Wrap(err, "flush transactions")
+		}
+	}
+
+	r.tlock.Lock()
+	defer r.tlock.Unlock()
+	deferred := len(r.replays)
+	for _, pending := range r.txs {
+		deferred += len(pending.deferred)
+	}
+	if deferred > 0 {
+		return errors.Wrapf(xerrors.ErrEntityLocked, "%d events deferred by transactions", deferred)
+	}
+	return nil
+}
+
+// deferTxEvent returns true if event deferred, entity locked by transaction.
+func (r *Runtime) deferTxEvent(ev v1.Event) bool {
+	switch ev.Type() {
+	case v1.ETEntity, v1.ETSystem:
+		if ev.Attr(v1.MetaTxID) != "" {
+			return false
+		}
+	default:
+		return false
+	}
+
+	r.tlock.Lock()
+	defer r.tlock.Unlock()
+	pending, has := r.txs[ev.Entity()]
+	if has {
+		log.L().Debug("defer event, entity locked", zfield.ID(ev.ID()),
+			zfield.Eid(ev.Entity()), zap.String("tx", pending.id))
+		pending.deferred = append(pending.deferred, ev)
+	}
+	return has
+}
+
+// replayTxEvents handle events deferred by transactions completed, in order.
+func (r *Runtime) replayTxEvents(ctx context.Context) {
+	r.tlock.Lock()
+	events := r.replays
+	r.replays = nil
+	r.tlock.Unlock()
+
+	for _, ev := range events {
+		r.HandleEvent(ctx, ev)
+	}
+}
@@ -0,0 +1,137 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/core/pkg/types"
+)
+
+func txEvent(eid, txID string, phase v1.TxPhase, patches ...*v1.PatchData) *v1.ProtoEvent {
+	metadata := map[string]string{
+		v1.MetaType:     string(v1.ETEntity),
+		v1.MetaEntityID: eid}
+	if txID != "" {
+		metadata[v1.MetaTxID] = txID
+		metadata[v1.MetaTxPhase] = string(phase)
+	}
+
+	return &v1.ProtoEvent{
+		Id:       "ev-" + string(phase),
+		Callback: "http://localhost/v1/respond",
+		Metadata: metadata,
+		Data: &v1.ProtoEvent_Patches{
+			Patches: &v1.PatchDatas{Patches: patches}},
+	}
+}
+
+func lastResponse(d *eventRecorder) (types.Status, string) {
+	ev := d.events[len(d.events)-1]
+	return types.Status(ev.Attr(v1.MetaResponseStatus)), ev.Attr(v1.MetaResponseErrCode)
+}
+
+func TestRuntime_Transaction(t *testing.T) {
+	dispatcher := &eventRecorder{}
+	rt, repo := newTestRuntime(dispatcher, 0)
+
+	en, err := NewEntity("device123", []byte(`{"version": 1, "properties": {"temp": 20}}`))
+	assert.Nil(t, err)
+	rt.entities.Put("device123", en, false)
+	temp := func() string {
+		state, _ := rt.LoadEntity("device123")
+		return state.Get("properties.temp").String()
+	}
+
+	// prepare, entity unchanged until committed.
+	setTemp := &v1.PatchData{Operator: "replace", Path: "properties.temp", Value: []byte("50")}
+	rt.HandleEvent(context.Background(), txEvent("device123", "tx-1", v1.TxPrepare, setTemp))
+	status, _ := lastResponse(dispatcher)
+	assert.Equal(t, types.StatusOK, status)
+	assert.Equal(t, "20", temp())
+
+	// events of locked entity deferred.
+	setHum := &v1.PatchData{Operator: "replace", Path: "properties.hum", Value: []byte("40")}
+	rt.HandleEvent(context.Background(), txEvent("device123", "", "", setHum))
+	responded := len(dispatcher.events)
+
+	// other transactions rejected.
+	rt.HandleEvent(context.Background(), txEvent("device123", "tx-2", v1.TxPrepare, setTemp))
+	status, code := lastResponse(dispatcher)
+	assert.Equal(t, types.StatusError, status)
+	assert.Equal(t, xerrors.ErrEntityLocked.Error(), code)
+	assert.Equal(t, responded+1, len(dispatcher.events))
+
+	// commit, deferred events replayed after.
+	rt.HandleEvent(context.Background(), txEvent("device123", "tx-1", v1.TxCommit))
+	assert.Equal(t, "50", temp())
+	state, _ := rt.LoadEntity("device123")
+	assert.Equal(t, "40", state.Get("properties.hum").String())
+	assert.Equal(t, responded+3, len(dispatcher.events))
+	assert.Empty(t, rt.txs)
+
+	// commit unknown transaction.
+	rt.HandleEvent(context.Background(), txEvent("device123", "tx-1", v1.TxCommit))
+	_, code = lastResponse(dispatcher)
+	assert.Equal(t, xerrors.ErrTransactionNotFound.Error(), code)
+
+	// prepare failed, entity not locked.
+	rt.HandleEvent(context.Background(), txEvent("device123", "tx-3", v1.TxPrepare,
+		&v1.PatchData{Operator: "test", Path: "properties.temp", Value: []byte("20")}))
+	_, code = lastResponse(dispatcher)
+	assert.Equal(t, xerrors.ErrPatchTestFailed.Error(), code)
+	assert.Empty(t, rt.txs)
+
+	// abort, staged patches discarded.
+	rt.HandleEvent(context.Background(), txEvent("device123", "tx-4", v1.TxPrepare, setTemp))
+	rt.HandleEvent(context.Background(), txEvent("device123", "tx-4", v1.TxAbort))
+	assert.Equal(t, "50", temp())
+	assert.Empty(t, rt.txs)
+
+	// resolve by the decision of coordinator.
+	setTemp.Value = []byte("60")
+	rt.HandleEvent(context.Background(), txEvent("device123", "tx-5", v1.TxPrepare, setTemp))
+	repo.Transactions["tx-5"] = dao.Transaction{ID: "tx-5", Status: dao.TxCommitted}
+	rt.HandleEvent(context.Background(), txEvent("device123", "tx-5", v1.TxResolve))
+	assert.Equal(t, "60", temp())
+
+	// resolve undecided transaction, aborted.
+	setTemp.Value = []byte("70")
+	rt.HandleEvent(context.Background(), txEvent("device123", "tx-6", v1.TxPrepare, setTemp))
+	rt.HandleEvent(context.Background(), txEvent("device123", "tx-6", v1.TxResolve))
+	assert.Equal(t, "60", temp())
+	assert.Equal(t, dao.TxAborted, repo.Transactions["tx-6"].Status)
+	assert.Empty(t, rt.txs)
+}
+
+func TestRuntime_TransactionRestore(t *testing.T) {
+	dispatcher := &eventRecorder{}
+	rt, repo := newTestRuntime(dispatcher, 0)
+	en, err := NewEntity("device123", []byte(`{"version": 1, "properties": {"temp": 20}}`))
+	assert.Nil(t, err)
+	rt.entities.Put("device123", en, false)
+
+	setTemp := &v1.PatchData{Operator: "replace", Path: "properties.temp", Value: []byte("50")}
+	rt.HandleEvent(context.Background(), txEvent("device123", "tx-1", v1.TxPrepare, setTemp))
+	assert.Len(t, repo.TxLocks, 1)
+
+	// offsets of deferred events not committed.
+	setHum := &v1.PatchData{Operator: "replace", Path: "properties.hum", Value: []byte("40")}
+	rt.HandleEvent(context.Background(), txEvent("device123", "", "", setHum))
+	assert.ErrorIs(t, rt.FlushPending(context.Background()), xerrors.ErrEntityLocked)
+
+	// restarted, entity still locked by transaction prepared.
+	rt2 := NewRuntime(context.Background(), rt.entityResourcer, "core-1", dispatcher, repo, 0, 0)
+	assert.Nil(t, rt2.Restore(context.Background()))
+	rt2.entities.Put("device123", en, false)
+	assert.Contains(t, rt2.txs, "device123")
+
+	rt2.HandleEvent(context.Background(), txEvent("device123", "tx-1", v1.TxCommit))
+	state, _ := rt2.LoadEntity("device123")
+	assert.Equal(t, "50", state.Get("properties.temp").String())
+	assert.Nil(t, rt2.FlushPending(context.Background()))
+	assert.Empty(t, repo.TxLocks)
+}
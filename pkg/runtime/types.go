@@ -11,6 +11,7 @@ import (
 type Patch struct {
 	Op    xjson.PatchOp
 	Path  string
+	From  string
 	Value *tdtl.Collect
 }
 
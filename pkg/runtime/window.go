@@ -0,0 +1,211 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/core/pkg/mapper"
+	"github.com/tkeel-io/core/pkg/util"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+	"go.uber.org/zap"
+)
+
+// windowSnapshotID returns snapshot id of runtime windows.
+func windowSnapshotID(rid string) string {
+	return rid + "-windows"
+}
+
+func windowKey(mid, input string) string {
+	return mid + "/" + input
+}
+
+// applyWindows sample changed inputs into windows at the time of event, replace windowed inputs with aggregates,
+// returns false if the changes emit nothing, such as samples of windows emitted on close.
+func (r *Runtime) applyWindows(mp mapper.Mapper, in map[string]tdtl.Node, changed map[string]bool, at time.Time) bool {
+	emit := len(changed) == 0
+	windowed := make(map[string]bool)
+
+	r.wlock.Lock()
+	defer r.wlock.Unlock()
+	for _, w := range mp.Windows() {
+		windowed[w.Input] = true
+		key := windowKey(mp.ID(), w.Input)
+		state, has := r.windows[key]
+		if !has {
+			state = &mapper.WindowState{}
+			r.windows[key] = state
+		}
+
+		if val, has := in[w.Input]; has && changed[w.Input] && validValue(val) {
+			r.windowsDirty = true
+			if closed := state.Add(w, at, val); closed || w.Emit == mapper.EmitUpdate {
+				emit = true
+			}
+		}
+
+		if val := state.Value(w, at); nil != val {
+			in[w.Input] = val
+		} else {
+			delete(in, w.Input)
+		}
+	}
+
+	for key := range changed {
+		if !windowed[key] {
+			emit = true
+		}
+	}
+	return emit
+}
+
+// eventTime returns time of event, windows sampled at event time.
+func eventTime(ev v1.Event) time.Time {
+	if pe, ok := ev.(*v1.ProtoEvent); ok && pe.Timestamp > 0 {
+		return time.Unix(0, pe.Timestamp)
+	}
+	return time.Now()
+}
+
+func validValue(val tdtl.Node) bool {
+	return nil != val && nil == val.Error() &&
+		val.Type() != tdtl.Null && val.Type() != tdtl.Undefined
+}
+
+// closeWindows emit mappers of which tumbling windows closed, windows emitted on close only.
+func (r *Runtime) closeWindows(ctx context.Context, now time.Time) {
+	var mappers []mapper.Mapper
+	r.mlock.RLock()
+	for _, mc := range r.mapperCaches {
+		if len(mc.Mapper.Windows()) == 0 {
+			continue
+		}
+		// computed in runtime of target entity.
+		for _, tentacle := range mc.Tentacles {
+			if tentacle.Type() == mapper.TentacleTypeMapper {
+				mappers = append(mappers, mc.Mapper)
+				break
+			}
+		}
+	}
+	r.mlock.RUnlock()
+
+	for _, mp := range mappers {
+		var closed bool
+		r.wlock.Lock()
+		for _, w := range mp.Windows() {
+			if w.Emit != mapper.EmitClose {
+				continue
+			}
+			if state, has := r.windows[windowKey(mp.ID(), w.Input)]; has && state.Roll(w, now) {
+				r.windowsDirty = true
+				closed = true
+			}
+		}
+		r.wlock.Unlock()
+
+		if closed {
+			r.emitMapper(ctx, mp, now)
+		}
+	}
+}
+
+// emitMapper compute mapper, dispatch result to target entity.
+func (r *Runtime) emitMapper(ctx context.Context, mp mapper.Mapper, now time.Time) {
+	result := r.computeMapper(ctx, mp, nil, now)
+	if len(result) == 0 {
+		return
+	}
+
+	patches := make([]*v1.PatchData, 0, len(result))
+	for path, val := range result {
+		patches = append(patches, &v1.PatchData{
+			Operator: xjson.OpReplace.String(),
+			Path:     path,
+			Value:    val.Raw(),
+		})
+	}
+
+	log.L().Debug("emit windowed mapper", zfield.ID(r.id),
+		zfield.Mid(mp.ID()), zfield.Eid(mp.TargetEntity()))
+	if err := r.dispatcher.Dispatch(ctx, &v1.ProtoEvent{
+		Id:        util.IG().EvID(),
+		Timestamp: now.UnixNano(),
+		Metadata: map[string]string{
+			v1.MetaType:     string(v1.ETEntity),
+			v1.MetaEntityID: mp.TargetEntity()},
+		Data: &v1.ProtoEvent_Patches{
+			Patches: &v1.PatchDatas{
+				Patches: patches,
+			}},
+	}); nil != err {
+		log.L().Error("emit windowed mapper", zap.Error(err), zfield.ID(r.id),
+			zfield.Mid(mp.ID()), zfield.Eid(mp.TargetEntity()))
+	}
+}
+
+// removeWindows drop states of mapper windows.
+func (r *Runtime) removeWindows(mid string) {
+	r.wlock.Lock()
+	defer r.wlock.Unlock()
+	for key := range r.windows {
+		if strings.HasPrefix(key, mid+"/") {
+			delete(r.windows, key)
+			r.windowsDirty = true
+		}
+	}
+}
+
+// snapshotWindows persist states of windows if changed since the last snapshot.
+func (r *Runtime) snapshotWindows(ctx context.Context) error {
+	r.wlock.Lock()
+	if !r.windowsDirty {
+		r.wlock.Unlock()
+		return nil
+	}
+	bytes, err := json.Marshal(r.windows)
+	r.windowsDirty = false
+	r.wlock.Unlock()
+	if nil != err {
+		return errors.Wrap(err, "encode windows snapshot")
+	}
+
+	if err = r.repository.PutSnapshot(ctx, windowSnapshotID(r.id), bytes); nil != err {
+		r.wlock.Lock()
+		r.windowsDirty = true
+		r.wlock.Unlock()
+		return errors.Wrap(err, "snapshot windows")
+	}
+	return nil
+}
+
+// restoreWindows rehydrate states of windows from the last snapshot.
+func (r *Runtime) restoreWindows(ctx context.Context) error {
+	bytes, err := r.repository.GetSnapshot(ctx, windowSnapshotID(r.id))
+	if nil != err {
+		if errors.Is(err, xerrors.ErrSnapshotNotFound) {
+			return nil
+		}
+		return errors.Wrap(err, "restore windows")
+	}
+
+	windows := make(map[string]*mapper.WindowState)
+	if err = json.Unmarshal(bytes, &windows); nil != err {
+		return errors.Wrap(err, "decode windows snapshot")
+	}
+
+	r.wlock.Lock()
+	defer r.wlock.Unlock()
+	for key, state := range windows {
+		r.windows[key] = state
+	}
+	log.L().Info("restore windows", zfield.ID(r.id), zap.Int("windows", len(windows)))
+	return nil
+}
@@ -0,0 +1,99 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/mapper"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/tdtl"
+)
+
+func TestRuntime_Windows(t *testing.T) {
+	rt, repo := newTestRuntime(nil, 0)
+
+	mp, err := mapper.NewMapper(dao.Mapper{
+		ID:  "mapper-1",
+		TQL: "insert into dst select src.temp as avg, src.state as changes, src.level as level",
+		Windows: []dao.Window{
+			{Input: "src.temp", Type: mapper.WindowSliding, Size: 120, Aggregate: mapper.AggregateAvg},
+			{Input: "src.state", Type: mapper.WindowTumbling, Size: 60, Aggregate: mapper.AggregateChanges, Emit: mapper.EmitClose},
+		},
+	}, 0)
+	assert.Nil(t, err)
+
+	now := time.Unix(6000, 0)
+	in := map[string]tdtl.Node{"src.temp": tdtl.IntNode(10), "src.state": tdtl.StringNode("on")}
+	assert.True(t, rt.applyWindows(mp, in, map[string]bool{"src.temp": true, "src.state": true}, now))
+	assert.Equal(t, "10.000000", in["src.temp"].String())
+	// nothing emitted before window closed.
+	_, has := in["src.state"]
+	assert.False(t, has)
+
+	in = map[string]tdtl.Node{"src.temp": tdtl.IntNode(20), "src.state": tdtl.StringNode("off")}
+	assert.False(t, rt.applyWindows(mp, in, map[string]bool{"src.state": true}, now.Add(time.Second)))
+	in = map[string]tdtl.Node{"src.temp": tdtl.IntNode(20)}
+	assert.True(t, rt.applyWindows(mp, in, map[string]bool{"src.temp": true}, now.Add(time.Second)))
+	assert.Equal(t, "15.000000", in["src.temp"].String())
+
+	// window states survive restarts.
+	assert.Nil(t, rt.snapshotWindows(context.Background()))
+	rt2 := NewRuntime(context.Background(), EntityResource{}, "core-1", nil, repo, 0, 0)
+	assert.Nil(t, rt2.restoreWindows(context.Background()))
+
+	in = map[string]tdtl.Node{"src.state": tdtl.StringNode("on")}
+	assert.True(t, rt2.applyWindows(mp, in, map[string]bool{"src.state": true}, now.Add(61*time.Second)))
+	assert.Equal(t, "1", in["src.state"].String())
+	assert.Equal(t, "15.000000", in["src.temp"].String())
+
+	rt2.removeWindows(mp.ID())
+	assert.Len(t, rt2.windows, 0)
+}
+
+func TestRuntime_TickWindows(t *testing.T) {
+	recorder := &eventRecorder{}
+	rt, _ := newTestRuntime(recorder, 0)
+
+	mp, err := mapper.NewMapper(dao.Mapper{
+		ID:  "mapper-1",
+		TQL: "insert into dst select src.state as changes",
+		Windows: []dao.Window{
+			{Input: "src.state", Type: mapper.WindowTumbling, Size: 60, Aggregate: mapper.AggregateCount, Emit: mapper.EmitClose},
+		},
+	}, 0)
+	assert.Nil(t, err)
+
+	en, err := NewEntity("src", []byte(`{"id":"src","properties":{"state":"on"}}`))
+	assert.Nil(t, err)
+	rt.entities.Put("src", en, false)
+
+	mc := MCache{ID: mp.ID(), EntityID: mp.TargetEntity(), Mapper: mp}
+	for _, tentacles := range mp.Tentacles() {
+		mc.Tentacles = append(mc.Tentacles, tentacles...)
+	}
+	rt.AppendMapper(mc)
+	recorder.events = nil
+
+	now := time.Unix(6000, 0)
+	in := map[string]tdtl.Node{"src.state": tdtl.StringNode("on")}
+	rt.applyWindows(mp, in, map[string]bool{"src.state": true}, now)
+
+	tick := func(at time.Time) v1.Event {
+		return &v1.ProtoEvent{
+			Id:        "tick",
+			Timestamp: at.UnixNano(),
+			Metadata:  map[string]string{v1.MetaType: string(v1.ETSystem), v1.MetaEntityID: "core-1"},
+			Data:      &v1.ProtoEvent_SystemData{SystemData: &v1.SystemData{Operator: string(v1.OpTick)}},
+		}
+	}
+
+	// windows closed by ticks of event loop.
+	assert.Nil(t, rt.HandleEvent(context.Background(), tick(now.Add(30*time.Second))))
+	assert.Len(t, recorder.events, 0)
+	assert.Nil(t, rt.HandleEvent(context.Background(), tick(now.Add(61*time.Second))))
+	assert.Len(t, recorder.events, 1)
+	assert.Equal(t, "dst", recorder.events[0].Entity())
+}
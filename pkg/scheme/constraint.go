@@ -26,13 +26,15 @@ const (
 	EnabledFlagTimeSeries
 )
 
-var callbacks = map[string]func(op Operator, val tdtl.Node) (tdtl.Node, error){
-	"max": func(op Operator, val tdtl.Node) (tdtl.Node, error) {
-		return val, nil
-	},
-	"size": func(op Operator, val tdtl.Node) (tdtl.Node, error) {
-		return val, nil
-	},
+// callbacks check decoded json value, returns violated reason.
+var callbacks = map[string]func(op Operator, val interface{}) error{
+	DefineFieldMin:         checkMin,
+	DefineFieldMax:         checkMax,
+	DefineFieldSize:        checkSize,
+	DefineFieldArrayLength: checkSize,
+	DefineFieldMinLength:   checkMinLength,
+	DefineFieldEnum:        checkEnum,
+	DefineFieldRegex:       checkRegex,
 }
 
 type Operator struct {
@@ -79,6 +81,20 @@ func genEnabledIndexes(prefix string, enabledFlag int, ct *Constraint) []string
 	return searchIndexes
 }
 
+// Child returns constraint of struct field.
+func (ct *Constraint) Child(id string) *Constraint {
+	if ct.Type != PropertyTypeStruct {
+		return nil
+	}
+
+	for _, child := range ct.ChildNodes {
+		if child.ID == id {
+			return child
+		}
+	}
+	return nil
+}
+
 func NewConstraintsFrom(cfg Config) *Constraint {
 	return parseConstraintFrom(cfg)
 }
@@ -139,6 +155,14 @@ func keyContains(key string) bool {
 	return flag
 }
 
+// ExecData check value against constraint, returns *ViolationError if violated.
 func ExecData(val tdtl.Node, ct *Constraint) (tdtl.Node, error) {
+	if nil == ct {
+		return val, nil
+	}
+
+	if violations := Validate(ct.ID, val, ct); len(violations) > 0 {
+		return val, &ViolationError{Violations: violations}
+	}
 	return val, nil
 }
@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheme
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+)
+
+// InheritNode scheme inheritance declaration of entity.
+type InheritNode struct {
+	ID string
+	// Templates ordered parent templates, the former takes precedence.
+	Templates []string
+	// Scheme effective scheme of entity.
+	Scheme map[string]json.RawMessage
+	// Origins templates which inherited entries come from, entries absent are local.
+	// nil if unknown, then entries defined by parents are regarded as inherited.
+	Origins map[string]string
+}
+
+// NodeLoader load inheritance declaration of template.
+type NodeLoader func(id string) (*InheritNode, error)
+
+// Resolved effective scheme and the entity where each entry is defined.
+type Resolved struct {
+	Scheme  map[string]json.RawMessage
+	Origins map[string]string
+}
+
+// Inherited returns origins of inherited entries.
+func (r *Resolved) Inherited(id string) map[string]string {
+	origins := make(map[string]string)
+	for key, origin := range r.Origins {
+		if origin != id {
+			origins[key] = origin
+		}
+	}
+	return origins
+}
+
+// Resolve merge parent templates recursively, local entries take precedence.
+func Resolve(root *InheritNode, load NodeLoader) (*Resolved, error) {
+	r := &resolver{load: load, resolved: map[string]*Resolved{}}
+	return r.resolve(root, nil)
+}
+
+type resolver struct {
+	load     NodeLoader
+	resolved map[string]*Resolved
+}
+
+func (r *resolver) resolve(node *InheritNode, path []string) (*Resolved, error) {
+	for _, id := range path {
+		if id == node.ID {
+			return nil, fmt.Errorf("%w: %s", xerrors.ErrTemplateCycle,
+				strings.Join(append(path, node.ID), " -> "))
+		}
+	}
+
+	if ret, ok := r.resolved[node.ID]; ok {
+		return ret, nil
+	}
+
+	path = append(path, node.ID)
+	ret := &Resolved{Scheme: map[string]json.RawMessage{}, Origins: map[string]string{}}
+	// the former takes precedence, merge in reverse order.
+	for index := len(node.Templates) - 1; index >= 0; index-- {
+		parent, err := r.load(node.Templates[index])
+		if nil != err {
+			// loader describes the error, keep error code as prefix.
+			return nil, err
+		}
+
+		parentRet, err := r.resolve(parent, path)
+		if nil != err {
+			return nil, err
+		}
+
+		for key, cfg := range parentRet.Scheme {
+			ret.Scheme[key] = cfg
+			ret.Origins[key] = parentRet.Origins[key]
+		}
+	}
+
+	// local entries.
+	for key, cfg := range node.Scheme {
+		if _, inherited := node.Origins[key]; inherited {
+			continue
+		} else if _, defined := ret.Scheme[key]; nil == node.Origins && defined {
+			continue
+		}
+		ret.Scheme[key] = cfg
+		ret.Origins[key] = node.ID
+	}
+
+	r.resolved[node.ID] = ret
+	return ret, nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheme
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+)
+
+func TestResolve(t *testing.T) {
+	nodes := map[string]*InheritNode{
+		"base": {ID: "base", Scheme: map[string]json.RawMessage{
+			"temp": json.RawMessage(`{"type":"int"}`),
+			"name": json.RawMessage(`{"type":"string"}`)}},
+		"sensor": {ID: "sensor", Templates: []string{"base"}, Scheme: map[string]json.RawMessage{
+			"temp": json.RawMessage(`{"type":"float"}`),
+			"name": json.RawMessage(`{"type":"string"}`),
+			"hum":  json.RawMessage(`{"type":"float"}`)},
+			Origins: map[string]string{"name": "base"}},
+		"meter": {ID: "meter", Scheme: map[string]json.RawMessage{
+			"hum":   json.RawMessage(`{"type":"int"}`),
+			"power": json.RawMessage(`{"type":"int"}`)}},
+	}
+	load := func(id string) (*InheritNode, error) {
+		if node, ok := nodes[id]; ok {
+			return node, nil
+		}
+		return nil, xerrors.ErrTemplateNotFound
+	}
+
+	ret, err := Resolve(&InheritNode{ID: "device123", Templates: []string{"sensor", "meter"},
+		Scheme:  map[string]json.RawMessage{"power": json.RawMessage(`{"type":"float"}`)},
+		Origins: map[string]string{}}, load)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{
+		"temp":  "sensor",
+		"name":  "base",
+		"hum":   "sensor",
+		"power": "device123",
+	}, ret.Origins)
+	assert.JSONEq(t, `{"type":"float"}`, string(ret.Scheme["temp"]))
+	assert.JSONEq(t, `{"type":"float"}`, string(ret.Scheme["hum"]))
+	assert.JSONEq(t, `{"type":"float"}`, string(ret.Scheme["power"]))
+	assert.Equal(t, map[string]string{"temp": "sensor", "name": "base", "hum": "sensor"}, ret.Inherited("device123"))
+
+	// legacy entity without origins, entries defined by templates are inherited.
+	ret, err = Resolve(&InheritNode{ID: "device123", Templates: []string{"meter"},
+		Scheme: map[string]json.RawMessage{
+			"hum":  json.RawMessage(`{"type":"string"}`),
+			"temp": json.RawMessage(`{"type":"int"}`)}}, load)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"type":"int"}`, string(ret.Scheme["hum"]))
+	assert.Equal(t, "meter", ret.Origins["hum"])
+	assert.Equal(t, "device123", ret.Origins["temp"])
+
+	_, err = Resolve(&InheritNode{ID: "device123", Templates: []string{"unknown"}}, load)
+	assert.ErrorIs(t, err, xerrors.ErrTemplateNotFound)
+
+	// base -> sensor -> base.
+	nodes["base"].Templates = []string{"sensor"}
+	_, err = Resolve(&InheritNode{ID: "device123", Templates: []string{"sensor"}}, load)
+	assert.ErrorIs(t, err, xerrors.ErrTemplateCycle)
+	assert.Contains(t, err.Error(), "sensor -> base -> sensor")
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheme
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/tdtl"
+)
+
+const (
+	DefineFieldMin       = "min"
+	DefineFieldMax       = "max"
+	DefineFieldSize      = "size"
+	DefineFieldMinLength = "min_length"
+	DefineFieldEnum      = "enum"
+	DefineFieldRegex     = "regex"
+)
+
+// Violation property value not satisfied with constraint.
+type Violation struct {
+	Path   string `json:"path"`
+	Reason string `json:"reason"`
+}
+
+// ViolationError lists all violations of a write.
+type ViolationError struct {
+	Violations []Violation
+}
+
+func (e *ViolationError) Error() string {
+	reasons := make([]string, len(e.Violations))
+	for index, v := range e.Violations {
+		reasons[index] = v.Path + " " + v.Reason
+	}
+	return xerrors.ErrSchemeViolated.Error() + ": " + strings.Join(reasons, "; ")
+}
+
+func (e *ViolationError) Unwrap() error {
+	return xerrors.ErrSchemeViolated
+}
+
+// Validate returns violations of value against constraint, path is the path of value.
+func Validate(path string, val tdtl.Node, ct *Constraint) []Violation {
+	if nil == ct {
+		return nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(val.Raw(), &v); nil != err {
+		return []Violation{{Path: path, Reason: "invalid json value"}}
+	}
+	return validate(path, v, ct)
+}
+
+func validate(path string, val interface{}, ct *Constraint) []Violation {
+	// null clears property.
+	if nil == val {
+		return nil
+	}
+
+	if reason := checkType(ct.Type, val); reason != "" {
+		return []Violation{{Path: path, Reason: reason}}
+	}
+
+	var violations []Violation
+	for _, op := range ct.Operators {
+		if err := callbacks[op.Callback](op, val); nil != err {
+			violations = append(violations, Violation{Path: path, Reason: err.Error()})
+		}
+	}
+
+	switch ct.Type {
+	case PropertyTypeStruct:
+		fields, _ := val.(map[string]interface{})
+		for _, child := range ct.ChildNodes {
+			if field, ok := fields[child.ID]; ok {
+				violations = append(violations, validate(path+"."+child.ID, field, child)...)
+			}
+		}
+	case PropertyTypeArray:
+		elems, _ := val.([]interface{})
+		for _, child := range ct.ChildNodes {
+			for index, elem := range elems {
+				violations = append(violations, validate(fmt.Sprintf("%s[%d]", path, index), elem, child)...)
+			}
+		}
+	}
+
+	return violations
+}
+
+func checkType(typ string, val interface{}) string {
+	var ok bool
+	switch typ {
+	case PropertyTypeInt:
+		var num float64
+		if num, ok = val.(float64); ok {
+			ok = num == math.Trunc(num)
+		}
+	case PropertyTypeFloat, PropertyTypeDouble:
+		_, ok = val.(float64)
+	case PropertyTypeBool:
+		_, ok = val.(bool)
+	case PropertyTypeString:
+		_, ok = val.(string)
+	case PropertyTypeArray:
+		_, ok = val.([]interface{})
+	case PropertyTypeStruct:
+		_, ok = val.(map[string]interface{})
+	default:
+		ok = true
+	}
+
+	if !ok {
+		return "expect type " + typ
+	}
+	return ""
+}
+
+func checkMin(op Operator, val interface{}) error {
+	num, ok1 := val.(float64)
+	min, ok2 := toFloat(op.Condition)
+	if ok1 && ok2 && num < min {
+		return errors.Errorf("less than min %v", op.Condition)
+	}
+	return nil
+}
+
+func checkMax(op Operator, val interface{}) error {
+	num, ok1 := val.(float64)
+	max, ok2 := toFloat(op.Condition)
+	if ok1 && ok2 && num > max {
+		return errors.Errorf("greater than max %v", op.Condition)
+	}
+	return nil
+}
+
+// checkSize check length of string or array.
+func checkSize(op Operator, val interface{}) error {
+	var size int
+	switch v := val.(type) {
+	case string:
+		size = utf8.RuneCountInString(v)
+	case []interface{}:
+		size = len(v)
+	default:
+		return nil
+	}
+
+	if max, ok := toFloat(op.Condition); ok && float64(size) > max {
+		return errors.Errorf("length exceeds %v", op.Condition)
+	}
+	return nil
+}
+
+// checkMinLength check length of string.
+func checkMinLength(op Operator, val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return nil
+	}
+
+	if min, ok := toFloat(op.Condition); ok && float64(utf8.RuneCountInString(str)) < min {
+		return errors.Errorf("length less than %v", op.Condition)
+	}
+	return nil
+}
+
+func checkEnum(op Operator, val interface{}) error {
+	cond := reflect.ValueOf(op.Condition)
+	if cond.Kind() != reflect.Slice {
+		return nil
+	}
+
+	for index := 0; index < cond.Len(); index++ {
+		item := cond.Index(index).Interface()
+		if num, ok := toFloat(item); ok {
+			item = num
+		}
+		if reflect.DeepEqual(item, val) {
+			return nil
+		}
+	}
+	return errors.Errorf("not in enum %v", op.Condition)
+}
+
+var regexps sync.Map
+
+func checkRegex(op Operator, val interface{}) error {
+	str, ok1 := val.(string)
+	expr, ok2 := op.Condition.(string)
+	if !ok1 || !ok2 {
+		return nil
+	}
+
+	var re *regexp.Regexp
+	if cached, ok := regexps.Load(expr); ok {
+		re, _ = cached.(*regexp.Regexp)
+	} else {
+		var err error
+		if re, err = regexp.Compile(expr); nil != err {
+			return errors.Errorf("invalid regex %s", expr)
+		}
+		regexps.Store(expr, re)
+	}
+
+	if !re.MatchString(str) {
+		return errors.Errorf("not match regex %s", expr)
+	}
+	return nil
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch num := v.(type) {
+	case int:
+		return float64(num), true
+	case int32:
+		return float64(num), true
+	case int64:
+		return float64(num), true
+	case float32:
+		return float64(num), true
+	case float64:
+		return num, true
+	case json.Number:
+		f, err := num.Float64()
+		return f, nil == err
+	default:
+		return 0, false
+	}
+}
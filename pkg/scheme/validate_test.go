@@ -0,0 +1,65 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheme
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/tdtl"
+)
+
+func TestValidate(t *testing.T) {
+	cfg, err := ParseFrom([]byte(`{"id": "device", "type": "struct", "enabled": true, "define": {"fields": {
+		"temp": {"id": "temp", "type": "float", "enabled": true, "define": {"min": -20, "max": 60}},
+		"name": {"id": "name", "type": "string", "enabled": true, "define": {"size": 4, "min_length": 2, "regex": "^[a-z]+$"}},
+		"on": {"id": "on", "type": "bool", "enabled": true},
+		"tags": {"id": "tags", "type": "array", "enabled": true, "define": {"length": 2,
+			"elem_type": {"id": "tag", "type": "int", "enabled": true, "define": {"enum": [1, 2, 3]}}}}}}}`))
+	assert.Nil(t, err)
+	ct := NewConstraintsFrom(*cfg)
+
+	tests := []struct {
+		name  string
+		value string
+		paths []string
+	}{
+		{"valid", `{"temp": 20.5, "name": "abc", "on": true, "tags": [1, 3]}`, nil},
+		{"type", `{"temp": "hot", "on": 1}`, []string{"device.temp", "device.on"}},
+		{"range", `{"temp": 61}`, []string{"device.temp"}},
+		{"string", `{"name": "ABCDE"}`, []string{"device.name", "device.name"}},
+		{"min length", `{"name": "a"}`, []string{"device.name"}},
+		{"array", `{"tags": [1, 4, 2]}`, []string{"device.tags", "device.tags[1]"}},
+		{"struct", `[]`, []string{"device"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var paths []string
+			for _, v := range Validate("device", tdtl.New(tt.value), ct) {
+				paths = append(paths, v.Path)
+			}
+			assert.ElementsMatch(t, tt.paths, paths)
+		})
+	}
+
+	_, err = ExecData(tdtl.New(`{"temp": 100}`), ct)
+	assert.ErrorIs(t, err, xerrors.ErrSchemeViolated)
+	assert.Equal(t, "Core.Entity.Scheme.Violated: device.temp greater than max 60", err.Error())
+	assert.ErrorIs(t, xerrors.New(err.Error()), xerrors.ErrSchemeViolated)
+}
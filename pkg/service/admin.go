@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/runtime"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/atomic"
+)
+
+// NodeStats statistics of runtimes of core node.
+type NodeStats interface {
+	Stats() map[string]runtime.ResidencyStats
+	StageStats() map[string]map[string]runtime.StageStats
+}
+
+// RuntimeStats statistics of runtime, entity residency and event handling stages.
+type RuntimeStats struct {
+	Residency runtime.ResidencyStats        `json:"residency"`
+	Stages    map[string]runtime.StageStats `json:"stages"`
+}
+
+// PlacementPreviewRequest queues to preview placement of entities against.
+type PlacementPreviewRequest struct {
+	Keys   []string         `json:"keys"`
+	Queues []placement.Info `json:"queues"`
+}
+
+// AdminService serve operational statistics of core node.
+type AdminService struct {
+	inited    *atomic.Bool
+	node      NodeStats
+	placement placement.Placement
+}
+
+// NewAdminService returns a new AdminService.
+func NewAdminService() *AdminService {
+	return &AdminService{
+		inited:    atomic.NewBool(false),
+		placement: placement.Global(),
+	}
+}
+
+func (s *AdminService) Init(node NodeStats) {
+	s.node = node
+	s.inited.Store(true)
+}
+
+// Stats returns statistics of runtimes of node, keyed by runtime id.
+func (s *AdminService) Stats(ctx context.Context) (map[string]RuntimeStats, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready")
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	stats := make(map[string]RuntimeStats)
+	for rid, residency := range s.node.Stats() {
+		stats[rid] = RuntimeStats{Residency: residency}
+	}
+	for rid, stages := range s.node.StageStats() {
+		rs := stats[rid]
+		rs.Stages = stages
+		stats[rid] = rs
+	}
+	return stats, nil
+}
+
+// Placement returns queues entities currently placed on.
+func (s *AdminService) Placement(ctx context.Context) ([]placement.Info, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready")
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	return s.placement.Infos(), nil
+}
+
+// PreviewPlacement returns entities which would be moved if queues changed.
+func (s *AdminService) PreviewPlacement(ctx context.Context, req *PlacementPreviewRequest) ([]placement.Move, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready")
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	} else if len(req.Queues) == 0 {
+		return nil, errors.Wrap(xerrors.ErrInvalidParam, "queues required")
+	}
+
+	moves := s.placement.Preview(req.Keys, req.Queues)
+	if moves == nil {
+		moves = []placement.Move{}
+	}
+	return moves, nil
+}
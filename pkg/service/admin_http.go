@@ -0,0 +1,78 @@
+package service
+
+import (
+	"net/http"
+
+	go_restful "github.com/emicklei/go-restful"
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	kerrors "github.com/tkeel-io/kit/errors"
+	"github.com/tkeel-io/kit/result"
+	transportHTTP "github.com/tkeel-io/kit/transport/http"
+)
+
+// adminHTTPHandler serve AdminService as plain json.
+type adminHTTPHandler struct {
+	srv *AdminService
+}
+
+func (h *adminHTTPHandler) Stats(req *go_restful.Request, resp *go_restful.Response) {
+	ctx := transportHTTP.ContextWithHeader(req.Request.Context(), req.Request.Header)
+	out, err := h.srv.Stats(ctx)
+	writeAdminResult(resp, out, err)
+}
+
+func (h *adminHTTPHandler) Placement(req *go_restful.Request, resp *go_restful.Response) {
+	ctx := transportHTTP.ContextWithHeader(req.Request.Context(), req.Request.Header)
+	out, err := h.srv.Placement(ctx)
+	writeAdminResult(resp, out, err)
+}
+
+func (h *adminHTTPHandler) PreviewPlacement(req *go_restful.Request, resp *go_restful.Response) {
+	in := PlacementPreviewRequest{}
+	if err := req.ReadEntity(&in); nil != err {
+		writeAdminResult(resp, nil, errors.Wrap(xerrors.ErrInvalidParam, err.Error()))
+		return
+	}
+
+	ctx := transportHTTP.ContextWithHeader(req.Request.Context(), req.Request.Header)
+	out, err := h.srv.PreviewPlacement(ctx, &in)
+	writeAdminResult(resp, out, err)
+}
+
+func writeAdminResult(resp *go_restful.Response, out interface{}, err error) {
+	if err != nil {
+		tErr := kerrors.FromError(err)
+		httpCode := kerrors.GRPCToHTTPStatusCode(tErr.GRPCStatus().Code())
+		resp.WriteHeaderAndJson(httpCode,
+			result.Set(tErr.Reason, tErr.Message, nil), "application/json")
+		return
+	}
+	resp.WriteHeaderAndJson(http.StatusOK,
+		result.Set(kerrors.Success.Reason, "", out), "application/json")
+}
+
+// RegisterAdminHTTPServer register AdminService to container.
+func RegisterAdminHTTPServer(container *go_restful.Container, srv *AdminService) {
+	var ws *go_restful.WebService
+	for _, v := range container.RegisteredWebServices() {
+		if v.RootPath() == "/v1" {
+			ws = v
+			break
+		}
+	}
+	if ws == nil {
+		ws = new(go_restful.WebService)
+		ws.ApiVersion("/v1")
+		ws.Path("/v1").Produces(go_restful.MIME_JSON)
+		container.Add(ws)
+	}
+
+	handler := &adminHTTPHandler{srv: srv}
+	ws.Route(ws.GET("/admin/stats").
+		To(handler.Stats))
+	ws.Route(ws.GET("/admin/placement").
+		To(handler.Placement))
+	ws.Route(ws.POST("/admin/placement/preview").
+		To(handler.PreviewPlacement))
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/runtime"
+)
+
+type nodeStats struct{}
+
+func (nodeStats) Stats() map[string]runtime.ResidencyStats {
+	return map[string]runtime.ResidencyStats{"core-1": {Limit: 100, Resident: 2, Hits: 3}}
+}
+
+func (nodeStats) StageStats() map[string]map[string]runtime.StageStats {
+	return map[string]map[string]runtime.StageStats{"core-1": {runtime.StagePersistent: {Calls: 5}}}
+}
+
+func TestAdminService_Stats(t *testing.T) {
+	srv := NewAdminService()
+	_, err := srv.Stats(context.Background())
+	assert.NotNil(t, err)
+
+	srv.Init(nodeStats{})
+	stats, err := srv.Stats(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, stats["core-1"].Residency.Resident)
+	assert.Equal(t, int64(5), stats["core-1"].Stages[runtime.StagePersistent].Calls)
+}
+
+func TestAdminService_Placement(t *testing.T) {
+	srv := NewAdminService()
+	srv.placement = placement.New()
+	srv.placement.Append(placement.Info{ID: "core-1"})
+	srv.Init(nodeStats{})
+
+	infos, err := srv.Placement(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []placement.Info{{ID: "core-1"}}, infos)
+
+	_, err = srv.PreviewPlacement(context.Background(), &PlacementPreviewRequest{Keys: []string{"en-1"}})
+	assert.ErrorIs(t, err, xerrors.ErrInvalidParam)
+
+	moves, err := srv.PreviewPlacement(context.Background(), &PlacementPreviewRequest{
+		Keys:   []string{"en-1"},
+		Queues: []placement.Info{{ID: "core-2"}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []placement.Move{{Key: "en-1", From: "core-1", To: "core-2"}}, moves)
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	apim "github.com/tkeel-io/core/pkg/manager"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/zap"
+)
+
+// max items of batch request.
+const maxBatchItems = 1000
+
+// BatchCreateEntities create entities, failures of items not affect others.
+func (s *EntityService) BatchCreateEntities(ctx context.Context, req *pb.BatchCreateEntitiesRequest) (*pb.BatchEntitiesResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready")
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	} else if err := checkBatch(len(req.Items)); nil != err {
+		return nil, errors.Wrap(err, "batch create entities")
+	}
+
+	items := make([]*apim.BatchItem, len(req.Items))
+	results := make([]*pb.BatchEntityResult, len(req.Items))
+	for index, item := range req.Items {
+		entity, err := parseCreateEntity(ctx, item)
+		if nil != err {
+			results[index] = batchResult(item.Id, nil, err)
+			continue
+		}
+		items[index] = &apim.BatchItem{Op: apim.BatchCreate, Entity: entity}
+	}
+
+	return s.batch(ctx, items, results), nil
+}
+
+// BatchPatchEntities patch properties of entities, failures of items not affect others.
+func (s *EntityService) BatchPatchEntities(ctx context.Context, req *pb.BatchPatchEntitiesRequest) (*pb.BatchEntitiesResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready")
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	} else if err := checkBatch(len(req.Items)); nil != err {
+		return nil, errors.Wrap(err, "batch patch entities")
+	}
+
+	items := make([]*apim.BatchItem, len(req.Items))
+	results := make([]*pb.BatchEntityResult, len(req.Items))
+	for index, item := range req.Items {
+		entity := &Entity{ID: item.Id, Type: item.Type, Owner: item.Owner, Source: item.Source}
+		parseHeaderFrom(ctx, entity)
+		patches, err := parsePatches(item.Id, item.Properties)
+		if nil != err {
+			results[index] = batchResult(item.Id, nil, err)
+			continue
+		}
+
+		opts := []apim.Option{apim.NewExpectedVersionOption(parseExpectedVersion(ctx, item.ExpectedVersion))}
+		items[index] = &apim.BatchItem{Op: apim.BatchPatch, Entity: entity, Patches: patches, Opts: opts}
+	}
+
+	return s.batch(ctx, items, results), nil
+}
+
+// BatchDeleteEntities delete entities, failures of items not affect others.
+func (s *EntityService) BatchDeleteEntities(ctx context.Context, req *pb.BatchDeleteEntitiesRequest) (*pb.BatchEntitiesResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready")
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	} else if err := checkBatch(len(req.Items)); nil != err {
+		return nil, errors.Wrap(err, "batch delete entities")
+	}
+
+	items := make([]*apim.BatchItem, len(req.Items))
+	for index, item := range req.Items {
+		entity := &Entity{ID: item.Id, Type: item.Type, Owner: item.Owner, Source: item.Source}
+		parseHeaderFrom(ctx, entity)
+		opts := []apim.Option{apim.NewExpectedVersionOption(parseExpectedVersion(ctx, item.ExpectedVersion))}
+		items[index] = &apim.BatchItem{Op: apim.BatchDelete, Entity: entity, Opts: opts}
+	}
+
+	return s.batch(ctx, items, make([]*pb.BatchEntityResult, len(req.Items))), nil
+}
+
+func checkBatch(size int) error {
+	if size == 0 || size > maxBatchItems {
+		return errors.Wrapf(xerrors.ErrInvalidParam, "items of batch must be in [1, %d]", maxBatchItems)
+	}
+	return nil
+}
+
+// batch operate valid items by one call of api manager, items failed
+// before the call are nil with their results, results in the order of items.
+func (s *EntityService) batch(ctx context.Context, items []*apim.BatchItem, results []*pb.BatchEntityResult) *pb.BatchEntitiesResponse {
+	var indexes []int
+	var valids []*apim.BatchItem
+	for index, item := range items {
+		if nil != item {
+			indexes = append(indexes, index)
+			valids = append(valids, item)
+		}
+	}
+
+	if len(valids) > 0 {
+		for index, res := range s.apiManager.BatchEntities(ctx, valids) {
+			item := valids[index]
+			var entity *pb.EntityResponse
+			if nil == res.Err && nil != res.Ret {
+				// clip copy properties.
+				if properties, cpflag, innerErr := CopyFrom(res.Raw, item.Patches...); nil != innerErr {
+					log.L().Warn("batch entities", zfield.Eid(item.Entity.ID), zfield.Reason(innerErr.Error()))
+				} else if cpflag {
+					res.Ret.Properties = properties
+				}
+				entity, res.Err = s.makeResponse(res.Ret)
+			}
+			results[indexes[index]] = batchResult(item.Entity.ID, entity, res.Err)
+		}
+	}
+
+	return batchResponse(results)
+}
+
+func batchResult(id string, out *pb.EntityResponse, err error) *pb.BatchEntityResult {
+	if nil != err {
+		log.L().Warn("batch item failed", zap.Error(err), zfield.Eid(id))
+		// respond error code to caller.
+		return &pb.BatchEntityResult{Id: id, Error: errors.Cause(err).Error()}
+	}
+
+	if nil != out {
+		id = out.Id
+	}
+	return &pb.BatchEntityResult{Id: id, Entity: out}
+}
+
+func batchResponse(results []*pb.BatchEntityResult) *pb.BatchEntitiesResponse {
+	out := &pb.BatchEntitiesResponse{
+		Total: int32(len(results)),
+		Items: results,
+	}
+
+	for _, result := range results {
+		if result.Error == "" {
+			out.Succeeded++
+		} else {
+			out.Failed++
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test_BatchCreateEntities(t *testing.T) {
+	properties, err := structpb.NewValue(map[string]interface{}{"temp": 20})
+	assert.Nil(t, err)
+	invalid, err := structpb.NewValue([]interface{}{"temp"})
+	assert.Nil(t, err)
+
+	out, err := entityService.BatchCreateEntities(context.Background(), &pb.BatchCreateEntitiesRequest{
+		Items: []*pb.CreateEntityRequest{
+			{Id: "device1", Owner: "admin", Type: "DEVICE", Source: "dm", Properties: properties},
+			{Id: "device2", Owner: "admin", Type: "DEVICE", Source: "dm", Properties: invalid},
+			{Id: "device3", Owner: "admin", Type: "DEVICE", Source: "dm"},
+		}})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(3), out.Total)
+	assert.Equal(t, int32(2), out.Succeeded)
+	assert.Equal(t, int32(1), out.Failed)
+	assert.Equal(t, "device2", out.Items[1].Id)
+	assert.Equal(t, xerrors.ErrInvalidEntityParams.Error(), out.Items[1].Error)
+	assert.Equal(t, "device3", out.Items[2].Entity.Id)
+
+	_, err = entityService.BatchDeleteEntities(context.Background(), &pb.BatchDeleteEntitiesRequest{})
+	assert.ErrorIs(t, err, xerrors.ErrInvalidParam)
+}
+
+func Test_BatchPatchEntities(t *testing.T) {
+	patches, err := structpb.NewValue([]interface{}{
+		map[string]interface{}{"path": "temp", "operator": "replace", "value": 25}})
+	assert.Nil(t, err)
+	invalid, err := structpb.NewValue(map[string]interface{}{"temp": 25})
+	assert.Nil(t, err)
+
+	out, err := entityService.BatchPatchEntities(context.Background(), &pb.BatchPatchEntitiesRequest{
+		Items: []*pb.PatchEntityPropsRequest{
+			{Id: "device1", Owner: "admin", Type: "DEVICE", Source: "dm", Properties: invalid},
+			{Id: "device2", Owner: "admin", Type: "DEVICE", Source: "dm", Properties: patches},
+		}})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), out.Succeeded)
+	assert.Equal(t, xerrors.ErrInvalidRequest.Error(), out.Items[0].Error)
+	assert.Equal(t, "device2", out.Items[1].Entity.Id)
+
+	out, err = entityService.BatchDeleteEntities(context.Background(), &pb.BatchDeleteEntitiesRequest{
+		Items: []*pb.DeleteEntityRequest{{Id: "device1"}, {Id: "device2"}}})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), out.Succeeded)
+	assert.Equal(t, "device2", out.Items[1].Id)
+	assert.Nil(t, out.Items[1].Entity)
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/deadletter"
+	"github.com/tkeel-io/core/pkg/dispatch"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// max dead letters listed per page by default.
+const deadLetterPageSize = 100
+
+type DeadLetterService struct {
+	pb.UnimplementedDeadLetterServer
+	ctx        context.Context
+	cancel     context.CancelFunc
+	inited     *atomic.Bool
+	queue      deadletter.Queue
+	dispatcher dispatch.Dispatcher
+}
+
+// NewDeadLetterService returns a new DeadLetterService.
+func NewDeadLetterService(ctx context.Context) (*DeadLetterService, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &DeadLetterService{
+		ctx:    ctx,
+		cancel: cancel,
+		inited: atomic.NewBool(false),
+	}, nil
+}
+
+func (s *DeadLetterService) Init(queue deadletter.Queue, dispatcher dispatch.Dispatcher) {
+	s.queue = queue
+	s.dispatcher = dispatcher
+	s.inited.Store(true)
+}
+
+func (s *DeadLetterService) ListDeadLetter(ctx context.Context, req *pb.ListDeadLetterRequest) (*pb.ListDeadLetterResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", zfield.ID(req.RuntimeId))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = deadLetterPageSize
+	}
+
+	letters, err := s.queue.List(ctx, req.RuntimeId, req.PageToken, limit)
+	if nil != err {
+		log.L().Error("list dead letter", zap.Error(err), zfield.ID(req.RuntimeId))
+		return nil, errors.Wrap(err, "list dead letter")
+	}
+
+	out := &pb.ListDeadLetterResponse{}
+	for _, dl := range letters {
+		item := &pb.DeadLetterObject{
+			Id:        dl.ID,
+			RuntimeId: dl.RuntimeID,
+			Error:     dl.Error,
+			Attempts:  dl.Attempts,
+			Timestamp: dl.Timestamp,
+			Sink:      dl.Sink,
+			Dropped:   dl.Dropped,
+		}
+
+		// events forwarded kept by sink only.
+		if len(dl.Event) > 0 {
+			var ev pb.ProtoEvent
+			if err = pb.Unmarshal(dl.Event, &ev); nil != err {
+				log.L().Warn("decode dead letter event", zap.Error(err), zfield.ID(dl.ID))
+			}
+			item.Event = &ev
+		}
+		out.Items = append(out.Items, item)
+	}
+	out.Count = int32(len(out.Items))
+	if int64(len(letters)) == limit {
+		last := letters[len(letters)-1]
+		out.NextPageToken = last.RuntimeID + "/" + last.ID
+	}
+
+	return out, nil
+}
+
+func (s *DeadLetterService) ReplayDeadLetter(ctx context.Context, req *pb.ReplayDeadLetterRequest) (*pb.ReplayDeadLetterResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", zfield.ID(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	if err := s.queue.Replay(ctx, req.RuntimeId, req.Id, s.dispatcher.Dispatch); nil != err {
+		log.L().Error("replay dead letter", zap.Error(err), zfield.ID(req.Id))
+		return nil, errors.Wrap(err, "replay dead letter")
+	}
+
+	return &pb.ReplayDeadLetterResponse{RuntimeId: req.RuntimeId, Id: req.Id}, nil
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -29,10 +30,13 @@ import (
 	apim "github.com/tkeel-io/core/pkg/manager"
 	"github.com/tkeel-io/core/pkg/scheme"
 	xjson "github.com/tkeel-io/core/pkg/util/json"
+	kerrors "github.com/tkeel-io/kit/errors"
 	"github.com/tkeel-io/kit/log"
 	"github.com/tkeel-io/tdtl"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"google.golang.org/protobuf/types/known/structpb"
 )
@@ -42,8 +46,13 @@ const (
 	FieldScheme      = "scheme"
 	FieldProps       = "properties"
 	FieldTemplate    = "template_id"
+	FieldTemplates   = "templates"
+	FieldSchemeMode  = "scheme_mode"
 	FieldDescription = "description"
 	InternalSep      = ".define.fields."
+
+	SchemeModeStrict  = "strict"
+	SchemeModeLenient = "lenient"
 )
 
 func schemeKey(key string) string {
@@ -91,37 +100,53 @@ func (s *EntityService) CreateEntity(ctx context.Context, req *pb.CreateEntityRe
 		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
 	}
 
-	entity := new(Entity)
+	entity, err := parseCreateEntity(ctx, req)
+	if nil != err {
+		return out, err
+	}
+
+	var baseRet *apim.BaseRet
+	if baseRet, err = s.apiManager.CreateEntity(ctx, entity); nil != err {
+		log.L().Error("create entity failed", zfield.Eid(req.Id), zap.Error(err))
+		return out, errors.Wrap(err, "create entity failed")
+	}
+
+	out, err = s.makeResponse(baseRet)
+	return out, errors.Wrap(err, "create entity failed")
+}
+
+// parseCreateEntity returns entity to be created from request.
+func parseCreateEntity(ctx context.Context, req *pb.CreateEntityRequest) (entity *Entity, err error) {
+	entity = new(Entity)
 	entity.ID = req.Id
 	entity.Owner = req.Owner
 	entity.Type = req.Type
 	entity.Source = req.Source
 	entity.TemplateID = req.From
+	entity.Templates = checkTemplates(req.Templates)
 	parseHeaderFrom(ctx, entity)
+	if entity.SchemeMode, err = checkSchemeMode(req.SchemeMode); nil != err {
+		log.L().Error("create entity, invalid scheme mode", zfield.Eid(req.Id), zap.Error(err))
+		return nil, errors.Wrap(err, "create entity")
+	}
+
 	properties := req.Properties.AsInterface()
 	switch properties.(type) {
 	case map[string]interface{}:
 		if entity.Properties, err = json.Marshal(properties); nil != err {
 			log.L().Error("create entity, invalid params", zfield.Reason(err.Error()),
 				zfield.Eid(req.Id), zap.Error(xerrors.ErrInvalidEntityParams))
-			return out, errors.Wrap(err, "create entity")
+			return nil, errors.Wrap(err, "create entity")
 		}
 	case nil:
 		log.L().Warn("create entity, empty params", zfield.Eid(req.Id))
 	default:
 		log.L().Error("create entity, but invalid params",
 			zfield.Eid(req.Id), zap.Error(xerrors.ErrInvalidEntityParams))
-		return out, xerrors.ErrInvalidEntityParams
-	}
-
-	var baseRet *apim.BaseRet
-	if baseRet, err = s.apiManager.CreateEntity(ctx, entity); nil != err {
-		log.L().Error("create entity failed", zfield.Eid(req.Id), zap.Error(err))
-		return out, errors.Wrap(err, "create entity failed")
+		return nil, xerrors.ErrInvalidEntityParams
 	}
 
-	out, err = s.makeResponse(baseRet)
-	return out, errors.Wrap(err, "create entity failed")
+	return entity, nil
 }
 
 func (s *EntityService) UpdateEntity(ctx context.Context, req *pb.UpdateEntityRequest) (out *pb.EntityResponse, err error) {
@@ -173,6 +198,26 @@ func (s *EntityService) UpdateEntity(ctx context.Context, req *pb.UpdateEntityRe
 		})
 	}
 
+	if templates := checkTemplates(req.Templates); len(templates) > 0 {
+		bytes, _ := json.Marshal(templates)
+		patches = append(patches, &pb.PatchData{
+			Path:     FieldTemplates,
+			Value:    bytes,
+			Operator: xjson.OpReplace.String(),
+		})
+	}
+
+	if mode, err := checkSchemeMode(req.SchemeMode); nil != err {
+		log.L().Error("update entity, invalid scheme mode", zfield.Eid(req.Id), zap.Error(err))
+		return out, errors.Wrap(err, "update entity")
+	} else if mode != "" {
+		patches = append(patches, &pb.PatchData{
+			Path:     FieldSchemeMode,
+			Value:    tdtl.NewString(mode).Raw(),
+			Operator: xjson.OpReplace.String(),
+		})
+	}
+
 	if len(req.Description) > 0 {
 		patches = append(patches, &pb.PatchData{
 			Path:     FieldDescription,
@@ -205,9 +250,10 @@ func (s *EntityService) UpdateEntity(ctx context.Context, req *pb.UpdateEntityRe
 	}
 
 	var baseRet *apim.BaseRet
-	if baseRet, _, err = s.apiManager.PatchEntity(ctx, entity, patches); nil != err {
+	opts := []apim.Option{apim.NewExpectedVersionOption(parseExpectedVersion(ctx, req.ExpectedVersion))}
+	if baseRet, _, err = s.apiManager.PatchEntity(ctx, entity, patches, opts...); nil != err {
 		log.L().Error("update entity failed.", zfield.Eid(req.Id), zap.Error(err))
-		return out, errors.Wrap(err, "update entity failed")
+		return out, withStatus(errors.Wrap(err, "update entity failed"))
 	}
 
 	out, err = s.makeResponse(baseRet)
@@ -228,7 +274,15 @@ func (s *EntityService) GetEntity(ctx context.Context, req *pb.GetEntityRequest)
 	parseHeaderFrom(ctx, entity)
 
 	var baseRet *apim.BaseRet
-	if baseRet, err = s.apiManager.GetEntity(ctx, entity); nil != err {
+	if req.AsOfVersion < 0 || req.AsOfTime < 0 {
+		return out, errors.Wrap(xerrors.ErrInvalidParam, "get entity, negative as_of")
+	} else if req.AsOfVersion > 0 || req.AsOfTime > 0 {
+		// rebuild entity from histories.
+		if baseRet, _, err = s.apiManager.GetEntityAt(ctx, entity, req.AsOfVersion, req.AsOfTime); nil != err {
+			log.L().Error("get entity at", zfield.Eid(req.Id), zap.Error(err))
+			return out, errors.Wrap(err, "get entity")
+		}
+	} else if baseRet, err = s.apiManager.GetEntity(ctx, entity); nil != err {
 		log.L().Error("get entity", zfield.Eid(req.Id), zap.Error(err))
 		return out, errors.Wrap(err, "get entity")
 	}
@@ -251,9 +305,10 @@ func (s *EntityService) DeleteEntity(ctx context.Context, req *pb.DeleteEntityRe
 	parseHeaderFrom(ctx, entity)
 
 	// delete entity.
-	if err = s.apiManager.DeleteEntity(ctx, entity); nil != err {
+	opts := []apim.Option{apim.NewExpectedVersionOption(parseExpectedVersion(ctx, req.ExpectedVersion))}
+	if err = s.apiManager.DeleteEntity(ctx, entity, opts...); nil != err {
 		log.L().Error("delete entity", zap.Error(err), zfield.ID(req.Id))
-		return nil, errors.Wrap(err, "delete entity")
+		return nil, withStatus(errors.Wrap(err, "delete entity"))
 	}
 
 	return &pb.DeleteEntityResponse{Id: req.Id, Status: "ok"}, nil
@@ -318,60 +373,77 @@ func (s *EntityService) PatchEntityProps(ctx context.Context, req *pb.PatchEntit
 	entity.Source = req.Source
 	parseHeaderFrom(ctx, entity)
 
+	patches, err := parsePatches(req.Id, req.Properties)
+	if nil != err {
+		return nil, err
+	}
+
+	var rawEntity []byte
+	var baseRet *apim.BaseRet
+	opts := []apim.Option{apim.NewExpectedVersionOption(parseExpectedVersion(ctx, req.ExpectedVersion))}
+	if baseRet, rawEntity, err = s.apiManager.PatchEntity(ctx, entity, patches, opts...); nil != err {
+		log.L().Error("patch entity properties.", zfield.Eid(req.Id), zap.Error(err))
+		return nil, withStatus(errors.Wrap(err, "patch entity properties"))
+	}
+
+	// clip copy properties.
+	if properties, cpflag, innerErr := CopyFrom(rawEntity, patches...); nil != innerErr {
+		log.L().Warn("patch entity properties.", zfield.Eid(req.Id), zfield.Reason(err.Error()))
+	} else if cpflag {
+		baseRet.Properties = properties
+	}
+
+	out, err = s.makeResponse(baseRet)
+	return out, errors.Wrap(err, "patch entity properties")
+}
+
+func (s *EntityService) PatchEntityPropsZ(ctx context.Context, req *pb.PatchEntityPropsRequest) (out *pb.EntityResponse, err error) {
+	return s.PatchEntityProps(ctx, req)
+}
+
+// parsePatches returns patches of entity properties.
+func parsePatches(eid string, properties *structpb.Value) ([]*pb.PatchData, error) {
 	patches := []*pb.PatchData{}
-	params := req.Properties.AsInterface()
+	params := properties.AsInterface()
 	switch params.(type) {
 	case []interface{}:
+		var err error
 		var data []byte
 		patchData := make([]PatchData, 0)
 		if data, err = json.Marshal(params); nil != err {
-			log.L().Error("patch entity properties.", zfield.Eid(req.Id), zap.Error(err))
+			log.L().Error("patch entity properties.", zfield.Eid(eid), zap.Error(err))
 			return nil, errors.Wrap(err, "json marshal patch data")
 		} else if err = json.Unmarshal(data, &patchData); nil != err {
-			log.L().Error("patch entity properties.", zfield.Eid(req.Id), zap.Error(err))
+			log.L().Error("patch entity properties.", zfield.Eid(eid), zap.Error(err))
 			return nil, errors.Wrap(err, "json unmarshal patch data")
 		}
 
 		for index := range patchData {
 			var bytes []byte
+			patchData[index].normalize()
 			if err = checkPatchData(patchData[index]); nil != err {
-				log.L().Error("patch entity properties.", zfield.Eid(req.Id), zap.Error(err))
+				log.L().Error("patch entity properties.", zfield.Eid(eid), zap.Error(err))
 				return nil, errors.Wrap(err, "patch entity properties")
 			} else if bytes, err = json.Marshal(patchData[index].Value); nil != err {
 				return nil, errors.Wrap(err, "encode property")
 			}
 			// encode value.
-			patches = append(patches, &pb.PatchData{
+			pd := &pb.PatchData{
 				Path:     propKey(patchData[index].Path),
 				Operator: patchData[index].Operator,
 				Value:    bytes,
-			})
+			}
+			if patchData[index].From != "" {
+				pd.From = propKey(patchData[index].From)
+			}
+			patches = append(patches, pd)
 		}
 	default:
-		log.L().Error("patch entity properties.", zfield.Eid(req.Id), zap.Error(xerrors.ErrInvalidRequest))
+		log.L().Error("patch entity properties.", zfield.Eid(eid), zap.Error(xerrors.ErrInvalidRequest))
 		return nil, xerrors.ErrInvalidRequest
 	}
 
-	var rawEntity []byte
-	var baseRet *apim.BaseRet
-	if baseRet, rawEntity, err = s.apiManager.PatchEntity(ctx, entity, patches); nil != err {
-		log.L().Error("patch entity properties.", zfield.Eid(req.Id), zap.Error(err))
-		return nil, errors.Wrap(err, "patch entity properties")
-	}
-
-	// clip copy properties.
-	if properties, cpflag, innerErr := CopyFrom(rawEntity, patches...); nil != innerErr {
-		log.L().Warn("patch entity properties.", zfield.Eid(req.Id), zfield.Reason(err.Error()))
-	} else if cpflag {
-		baseRet.Properties = properties
-	}
-
-	out, err = s.makeResponse(baseRet)
-	return out, errors.Wrap(err, "patch entity properties")
-}
-
-func (s *EntityService) PatchEntityPropsZ(ctx context.Context, req *pb.PatchEntityPropsRequest) (out *pb.EntityResponse, err error) {
-	return s.PatchEntityProps(ctx, req)
+	return patches, nil
 }
 
 func checkPatchData(patchData PatchData) error {
@@ -380,6 +452,19 @@ func checkPatchData(patchData PatchData) error {
 	} else if !xjson.IsValidPath(patchData.Path) {
 		return xerrors.ErrPatchPathInvalid
 	}
+
+	switch xjson.NewPatchOp(patchData.Operator) {
+	case xjson.OpMove:
+		// a path can not be moved into its own child.
+		if !xjson.IsValidPath(patchData.From) || xjson.IsChildPath(patchData.From, patchData.Path) {
+			return xerrors.ErrPatchPathInvalid
+		}
+	case xjson.OpCopy:
+		if patchData.From != "" && !xjson.IsValidPath(patchData.From) {
+			return xerrors.ErrPatchPathInvalid
+		}
+	default:
+	}
 	return nil
 }
 
@@ -405,8 +490,16 @@ func (s *EntityService) GetEntityProps(ctx context.Context, in *pb.GetEntityProp
 
 	var rawEntity []byte
 	var baseRet *apim.BaseRet
-	// get entity from entity manager.
-	if baseRet, rawEntity, err = s.apiManager.PatchEntity(ctx, entity, nil); nil != err {
+	if in.AsOfVersion < 0 || in.AsOfTime < 0 {
+		return out, errors.Wrap(xerrors.ErrInvalidParam, "get entity properties, negative as_of")
+	} else if in.AsOfVersion > 0 || in.AsOfTime > 0 {
+		// rebuild entity from histories.
+		if baseRet, rawEntity, err = s.apiManager.GetEntityAt(ctx, entity, in.AsOfVersion, in.AsOfTime); nil != err {
+			log.L().Error("get entity at", zfield.Eid(in.Id), zap.Error(err))
+			return out, errors.Wrap(err, "get entity properties")
+		}
+	} else if baseRet, rawEntity, err = s.apiManager.PatchEntity(ctx, entity, nil); nil != err {
+		// get entity from entity manager.
 		log.L().Error("patch entity failed.", zfield.Eid(in.Id), zap.Error(err))
 		return out, errors.Wrap(err, "get entity properties")
 	}
@@ -500,9 +593,10 @@ func (s *EntityService) UpdateEntityConfigs(ctx context.Context, in *pb.UpdateEn
 
 	// set entity configs.
 	var baseRet *apim.BaseRet
-	if baseRet, _, err = s.apiManager.PatchEntity(ctx, entity, patches); nil != err {
+	opts := []apim.Option{apim.NewExpectedVersionOption(parseExpectedVersion(ctx, in.ExpectedVersion))}
+	if baseRet, _, err = s.apiManager.PatchEntity(ctx, entity, patches, opts...); nil != err {
 		log.L().Error("update entity scheme", zfield.Eid(in.Id), zap.Error(err))
-		return out, errors.Wrap(err, "update entity scheme")
+		return out, withStatus(errors.Wrap(err, "update entity scheme"))
 	}
 
 	out, err = s.makeResponse(baseRet)
@@ -534,6 +628,7 @@ func (s *EntityService) PatchEntityConfigs(ctx context.Context, in *pb.PatchEnti
 		}
 
 		for index := range patchData {
+			patchData[index].normalize()
 			if err = checkPatchData(patchData[index]); nil != err {
 				log.L().Error("check entity scheme.", zfield.Eid(in.Id), zap.Error(err))
 				return nil, errors.Wrap(err, "patch entity scheme")
@@ -553,11 +648,15 @@ func (s *EntityService) PatchEntityConfigs(ctx context.Context, in *pb.PatchEnti
 				log.L().Error("json marshal", zap.Error(err), zfield.Eid(in.Id))
 				return nil, errors.Wrap(err, "patch entity scheme")
 			}
-			patches = append(patches, &pb.PatchData{
+			pd := &pb.PatchData{
 				Path:     schemeKey(patchData[index].Path),
 				Operator: patchData[index].Operator,
 				Value:    bytes,
-			})
+			}
+			if patchData[index].From != "" {
+				pd.From = schemeKey(patchData[index].From)
+			}
+			patches = append(patches, pd)
 		}
 
 	case nil:
@@ -642,6 +741,65 @@ func (s *EntityService) GetEntityConfigs(ctx context.Context, in *pb.GetEntityCo
 	return out, errors.Wrap(err, "get entity scheme")
 }
 
+// ResolveEntityConfigs returns configs inherited from templates, and where each config defined.
+func (s *EntityService) ResolveEntityConfigs(ctx context.Context, in *pb.ResolveEntityConfigsRequest) (out *pb.ResolveEntityConfigsResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", zfield.Eid(in.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	entity := new(Entity)
+	entity.ID = in.Id
+	entity.Type = in.Type
+	entity.Owner = in.Owner
+	entity.Source = in.Source
+	parseHeaderFrom(ctx, entity)
+
+	var baseRet *apim.BaseRet
+	if baseRet, err = s.apiManager.GetEntity(ctx, entity); nil != err {
+		log.L().Error("resolve entity configs", zfield.Eid(in.Id), zap.Error(err))
+		return nil, errors.Wrap(err, "resolve entity configs")
+	}
+
+	root, err := inheritNode(baseRet)
+	if nil != err {
+		return nil, errors.Wrap(err, "resolve entity configs")
+	}
+
+	resolved, err := scheme.Resolve(root, func(id string) (*scheme.InheritNode, error) {
+		ret, innerErr := s.apiManager.GetEntity(ctx, &Entity{ID: id})
+		if nil != innerErr {
+			return nil, errors.Wrapf(innerErr, "load template %s", id)
+		}
+		return inheritNode(ret)
+	})
+	if nil != err {
+		log.L().Error("resolve entity configs", zfield.Eid(in.Id), zap.Error(err))
+		return nil, errors.Wrap(err, "resolve entity configs")
+	}
+
+	configs := make(map[string]interface{})
+	for key, raw := range resolved.Scheme {
+		var cfg interface{}
+		if err = json.Unmarshal(raw, &cfg); nil != err {
+			return nil, errors.Wrap(err, "resolve entity configs")
+		}
+		configs[key] = cfg
+	}
+
+	out = &pb.ResolveEntityConfigsResponse{
+		Id:        baseRet.ID,
+		Templates: root.Templates,
+		Origins:   resolved.Origins,
+	}
+	if out.Configs, err = structpb.NewValue(configs); nil != err {
+		log.L().Error("convert entity scheme.", zap.Error(err), zfield.Eid(in.Id))
+		return nil, errors.Wrap(err, "convert entity scheme")
+	}
+
+	return out, nil
+}
+
 // RemoveConfigs remove entity configs.
 func (s *EntityService) RemoveEntityConfigs(ctx context.Context, in *pb.RemoveEntityConfigsRequest) (out *pb.EntityResponse, err error) {
 	if !s.inited.Load() {
@@ -727,6 +885,51 @@ func (s *EntityService) ListEntity(ctx context.Context, req *pb.ListEntityReques
 	return out, nil
 }
 
+// checkSchemeMode returns normalized scheme mode, empty means unspecified.
+func checkSchemeMode(mode string) (string, error) {
+	switch mode = strings.ToLower(strings.TrimSpace(mode)); mode {
+	case "", SchemeModeStrict, SchemeModeLenient:
+		return mode, nil
+	default:
+		return "", errors.Wrapf(xerrors.ErrInvalidParam, "scheme mode %s", mode)
+	}
+}
+
+// checkTemplates returns parent templates without blanks.
+func checkTemplates(templates []string) []string {
+	var out []string
+	for _, tid := range templates {
+		if tid = strings.TrimSpace(tid); tid != "" {
+			out = append(out, tid)
+		}
+	}
+	return out
+}
+
+// inheritNode returns scheme inheritance declaration of entity.
+func inheritNode(base *apim.BaseRet) (*scheme.InheritNode, error) {
+	node := &scheme.InheritNode{
+		ID:        base.ID,
+		Templates: base.Templates,
+		Scheme:    make(map[string]json.RawMessage),
+		Origins:   base.SchemeOrigins,
+	}
+
+	if len(node.Templates) == 0 && strings.TrimSpace(base.TemplateID) != "" {
+		node.Templates = []string{strings.TrimSpace(base.TemplateID)}
+	}
+
+	for key, cfg := range base.Scheme {
+		bytes, err := json.Marshal(cfg)
+		if nil != err {
+			return nil, errors.Wrap(err, "encode entity scheme")
+		}
+		node.Scheme[key] = bytes
+	}
+
+	return node, nil
+}
+
 // parseSchemeFrom parse config.
 func parseSchemeFrom(data interface{}) (out map[string]*scheme.Config, err error) {
 	// parse configs from.
@@ -774,6 +977,61 @@ func parseHeaderFrom(ctx context.Context, en *apim.Base) {
 	}
 }
 
+// parseExpectedVersion returns expected version from request, or from If-Match header.
+func parseExpectedVersion(ctx context.Context, version int64) int64 {
+	if version > 0 {
+		return version
+	}
+
+	if header, ok := ctx.Value(struct{}{}).(http.Header); ok {
+		etag := strings.TrimPrefix(header.Get(HeaderIfMatch), "W/")
+		version, _ = strconv.ParseInt(strings.Trim(etag, `"`), 10, 64)
+	}
+	return version
+}
+
+// statusError responds error with status code, still matched by errors.Is with the error.
+type statusError struct {
+	err    error
+	status *kerrors.TError
+}
+
+func (e *statusError) Error() string {
+	return e.err.Error()
+}
+
+func (e *statusError) Unwrap() error {
+	return e.err
+}
+
+// As lets kerrors.FromError find the status, from which http status code derived.
+func (e *statusError) As(target interface{}) bool {
+	if status, ok := target.(**kerrors.TError); ok {
+		*status = e.status
+		return true
+	}
+	return false
+}
+
+func (e *statusError) GRPCStatus() *status.Status {
+	return e.status.GRPCStatus()
+}
+
+// statusCodes codes of errors responded, others responded as unknown.
+var statusCodes = map[error]codes.Code{
+	xerrors.ErrEntityVersionConflict: codes.Aborted,
+}
+
+// withStatus returns error with status code if known.
+func withStatus(err error) error {
+	for target, code := range statusCodes {
+		if errors.Is(err, target) {
+			return &statusError{err: err, status: kerrors.New(int(code), target.Error(), err.Error())}
+		}
+	}
+	return err
+}
+
 func (s *EntityService) makeResponse(base *apim.BaseRet) (out *pb.EntityResponse, err error) {
 	if base == nil {
 		return
@@ -796,6 +1054,7 @@ func (s *EntityService) makeResponse(base *apim.BaseRet) (out *pb.EntityResponse
 				Name:        mDesc.Name,
 				Tql:         mDesc.Tql,
 				Description: mDesc.Description,
+				Windows:     mDesc.Windows,
 			})
 	}
 
@@ -806,6 +1065,8 @@ func (s *EntityService) makeResponse(base *apim.BaseRet) (out *pb.EntityResponse
 	out.Version = base.Version
 	out.LastTime = base.LastTime
 	out.TemplateId = base.TemplateID
+	out.Templates = base.Templates
+	out.SchemeMode = base.SchemeMode
 	out.Description = base.Description
 	return out, nil
 }
@@ -815,8 +1076,8 @@ func CopyFrom(raw []byte, patches ...*pb.PatchData) (map[string]interface{}, boo
 	cc := tdtl.New(raw)
 	result := make(map[string]interface{})
 	for _, patch := range patches {
-		switch patch.Operator {
-		case xjson.OpCopy.String():
+		switch {
+		case patch.Operator == xjson.OpCopy.String() && patch.From == "":
 			cpFlag = true
 			var val interface{}
 			if ret := cc.Get(patch.Path); ret.Error() != nil {
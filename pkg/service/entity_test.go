@@ -2,13 +2,17 @@ package service
 
 import (
 	"context"
+	"net/http"
 	"os"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
 	apim "github.com/tkeel-io/core/pkg/manager"
 	"github.com/tkeel-io/core/pkg/service/mock"
+	kerrors "github.com/tkeel-io/kit/errors"
 	"github.com/tkeel-io/kit/log"
 	"google.golang.org/protobuf/types/known/structpb"
 )
@@ -76,6 +80,12 @@ func Test_PatchEntity(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_CheckPatchDataMove(t *testing.T) {
+	assert.Nil(t, checkPatchData(PatchData{Operator: "move", From: "properties.metrics", Path: "properties.old"}))
+	assert.ErrorIs(t, checkPatchData(PatchData{Operator: "move",
+		From: "properties.metrics", Path: "properties.metrics.old"}), xerrors.ErrPatchPathInvalid)
+}
+
 func Test_DeleteEntity(t *testing.T) {
 	_, err := entityService.DeleteEntity(context.Background(), &pb.DeleteEntityRequest{
 		Id:     "device123",
@@ -107,6 +117,23 @@ func Test_GetEntity(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_GetEntityAsOf(t *testing.T) {
+	out, err := entityService.GetEntity(context.Background(), &pb.GetEntityRequest{
+		Id:          "device123",
+		Owner:       "admin",
+		AsOfVersion: 12,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(12), out.Version)
+
+	_, err = entityService.GetEntity(context.Background(), &pb.GetEntityRequest{
+		Id:       "device123",
+		Owner:    "admin",
+		AsOfTime: -1,
+	})
+	assert.ErrorIs(t, err, xerrors.ErrInvalidParam)
+}
+
 func Test_ListEntity(t *testing.T) {
 	_, err := entityService.ListEntity(context.Background(), &pb.ListEntityRequest{
 		Owner:  "admin",
@@ -269,6 +296,39 @@ func Test_GetEntityConfigs(t *testing.T) {
 	assert.Equal(t, "DEVICE", res.Type)
 }
 
+type templateManager struct {
+	apim.APIManager
+	entities map[string]*apim.BaseRet
+}
+
+func (m *templateManager) GetEntity(_ context.Context, in *apim.Base) (*apim.BaseRet, error) {
+	if en, ok := m.entities[in.ID]; ok {
+		return en, nil
+	}
+	return nil, xerrors.ErrEntityNotFound
+}
+
+func Test_ResolveEntityConfigs(t *testing.T) {
+	es, err := NewEntityService(context.Background())
+	assert.Nil(t, err)
+
+	es.Init(&templateManager{APIManager: apiManager, entities: map[string]*apim.BaseRet{
+		"base": {ID: "base", Scheme: map[string]interface{}{
+			"temp": map[string]interface{}{"type": "int"}}},
+		"sensor": {ID: "sensor", TemplateID: "base", Scheme: map[string]interface{}{
+			"temp": map[string]interface{}{"type": "float"},
+			"hum":  map[string]interface{}{"type": "float"}}, SchemeOrigins: map[string]string{}},
+		"device123": {ID: "device123", Templates: []string{"sensor"}, Scheme: map[string]interface{}{
+			"hum": map[string]interface{}{"type": "int"}}, SchemeOrigins: map[string]string{}},
+	}}, mock.NewSearchMock())
+
+	res, err := es.ResolveEntityConfigs(context.Background(), &pb.ResolveEntityConfigsRequest{Id: "device123"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"sensor"}, res.Templates)
+	assert.Equal(t, map[string]string{"temp": "sensor", "hum": "device123"}, res.Origins)
+	assert.Equal(t, "int", res.Configs.GetStructValue().Fields["hum"].GetStructValue().Fields["type"].GetStringValue())
+}
+
 func Test_PatchEntityConfigs(t *testing.T) {
 	configs, err := structpb.NewValue([]interface{}{
 		map[string]interface{}{
@@ -363,3 +423,13 @@ func TestCopyFrom(t *testing.T) {
 	assert.Nil(t, err)
 	t.Log("\nResult: ", result)
 }
+
+func Test_WithStatus(t *testing.T) {
+	err := withStatus(errors.Wrap(xerrors.ErrEntityVersionConflict, "patch entity properties"))
+	assert.ErrorIs(t, err, xerrors.ErrEntityVersionConflict)
+	assert.Equal(t, http.StatusConflict, kerrors.FromError(err).ToHTTPStatusCode())
+	assert.Equal(t, xerrors.ErrEntityVersionConflict.Error(), kerrors.FromError(err).Reason)
+
+	err = withStatus(errors.Wrap(xerrors.ErrEntityNotFound, "patch entity properties"))
+	assert.Equal(t, http.StatusInternalServerError, kerrors.FromError(err).ToHTTPStatusCode())
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	apim "github.com/tkeel-io/core/pkg/manager"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	defaultHistoryLimit = 100
+	maxHistoryLimit     = 1000
+)
+
+type HistoryService struct {
+	pb.UnimplementedHistoryServer
+	ctx        context.Context
+	cancel     context.CancelFunc
+	inited     *atomic.Bool
+	repo       repository.IRepository
+	apiManager apim.APIManager
+}
+
+// NewHistoryService returns a new HistoryService.
+func NewHistoryService(ctx context.Context) (*HistoryService, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &HistoryService{
+		ctx:    ctx,
+		cancel: cancel,
+		inited: atomic.NewBool(false),
+	}, nil
+}
+
+func (s *HistoryService) Init(apiManager apim.APIManager, repo repository.IRepository) {
+	s.repo = repo
+	s.apiManager = apiManager
+	s.inited.Store(true)
+}
+
+// ListHistory returns change histories of entity, ordered by version.
+func (s *HistoryService) ListHistory(ctx context.Context, req *pb.ListHistoryRequest) (*pb.ListHistoryResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", zfield.Eid(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultHistoryLimit
+	} else if limit < 0 || limit > maxHistoryLimit {
+		return nil, errors.Wrapf(xerrors.ErrInvalidParam, "limit must be in [1, %d]", maxHistoryLimit)
+	}
+
+	// histories visible only if entity visible.
+	entity := &Entity{ID: req.Id, Type: req.Type, Owner: req.Owner, Source: req.Source}
+	parseHeaderFrom(ctx, entity)
+	if _, err := s.apiManager.GetEntity(ctx, entity); nil != err {
+		log.L().Error("list history, get entity", zap.Error(err), zfield.Eid(req.Id))
+		return nil, errors.Wrap(err, "list history")
+	}
+
+	// one more history to determine the next page.
+	histories, err := s.repo.ListHistory(ctx, &dao.ListHistoryReq{
+		EntityID:     req.Id,
+		StartVersion: req.StartVersion,
+		EndVersion:   req.EndVersion,
+		StartTime:    req.StartTime,
+		EndTime:      req.EndTime,
+		Limit:        limit + 1,
+	})
+	if nil != err {
+		log.L().Error("list history", zap.Error(err), zfield.Eid(req.Id))
+		return nil, errors.Wrap(err, "list history")
+	}
+
+	out := &pb.ListHistoryResponse{}
+	if int64(len(histories)) > limit {
+		out.NextVersion = histories[limit].Version
+		histories = histories[:limit]
+	}
+
+	for index := range histories {
+		item, err := historyObject(&histories[index])
+		if nil != err {
+			log.L().Error("list history, convert history", zap.Error(err),
+				zfield.Eid(req.Id), zfield.Version(histories[index].Version))
+			return nil, errors.Wrap(err, "list history")
+		}
+		out.Items = append(out.Items, item)
+	}
+	out.Count = int32(len(out.Items))
+
+	return out, nil
+}
+
+func historyObject(h *dao.History) (*pb.HistoryObject, error) {
+	out := &pb.HistoryObject{
+		EntityId:  h.EntityID,
+		Version:   h.Version,
+		Timestamp: h.Timestamp,
+		EventId:   h.EventID,
+		Sender:    h.Sender,
+		Owner:     h.Owner,
+		RequestId: h.RequestID,
+		Deleted:   h.Deleted,
+	}
+
+	for _, patch := range h.Patches {
+		item, err := historyPatch(patch)
+		if nil != err {
+			return nil, err
+		}
+		out.Patches = append(out.Patches, item)
+	}
+
+	return out, nil
+}
+
+func historyPatch(patch dao.HistoryPatch) (*pb.HistoryPatch, error) {
+	var val interface{}
+	if len(patch.Value) > 0 {
+		if err := json.Unmarshal(patch.Value, &val); nil != err {
+			return nil, errors.Wrap(err, "decode history patch")
+		}
+	}
+
+	value, err := structpb.NewValue(val)
+	if nil != err {
+		return nil, errors.Wrap(err, "convert history patch")
+	}
+
+	return &pb.HistoryPatch{Op: patch.Op, Path: patch.Path, Value: value}, nil
+}
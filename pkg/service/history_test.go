@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	rmock "github.com/tkeel-io/core/pkg/runtime/mock"
+	"github.com/tkeel-io/core/pkg/service/mock"
+)
+
+func Test_ListHistory(t *testing.T) {
+	hs, err := NewHistoryService(context.Background())
+	assert.Nil(t, err)
+	repo := rmock.NewRepo()
+	for version := int64(1); version <= 5; version++ {
+		repo.Histories = append(repo.Histories, dao.History{EntityID: "device123", Version: version, Owner: "admin",
+			Patches: []dao.HistoryPatch{{Op: "replace", Path: "properties.temp", Value: []byte(`{"value":20}`)}}})
+	}
+	hs.Init(mock.NewAPIManagerMock(), repo)
+
+	res, err := hs.ListHistory(context.Background(), &pb.ListHistoryRequest{Id: "device123", StartVersion: 1, Limit: 3})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(3), res.Count)
+	assert.Equal(t, int64(4), res.NextVersion)
+	assert.Equal(t, "admin", res.Items[0].Owner)
+	assert.Equal(t, float64(20), res.Items[0].Patches[0].Value.GetStructValue().Fields["value"].GetNumberValue())
+
+	res, err = hs.ListHistory(context.Background(), &pb.ListHistoryRequest{Id: "device123", StartVersion: res.NextVersion})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), res.Count)
+	assert.Equal(t, int64(0), res.NextVersion)
+
+	_, err = hs.ListHistory(context.Background(), &pb.ListHistoryRequest{Id: "device123", Limit: maxHistoryLimit + 1})
+	assert.ErrorIs(t, err, xerrors.ErrInvalidParam)
+}
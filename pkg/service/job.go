@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+type JobService struct {
+	pb.UnimplementedJobServer
+	ctx    context.Context
+	cancel context.CancelFunc
+	inited *atomic.Bool
+	repo   repository.IRepository
+}
+
+// NewJobService returns a new JobService.
+func NewJobService(ctx context.Context) (*JobService, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &JobService{
+		ctx:    ctx,
+		cancel: cancel,
+		inited: atomic.NewBool(false),
+	}, nil
+}
+
+func (s *JobService) Init(repo repository.IRepository) {
+	s.repo = repo
+	s.inited.Store(true)
+}
+
+func (s *JobService) GetJob(ctx context.Context, req *pb.GetJobRequest) (*pb.JobObject, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", zfield.ID(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	job, err := s.repo.GetJob(ctx, &dao.Job{ID: req.Id})
+	if nil != err {
+		log.L().Error("get job", zap.Error(err), zfield.ID(req.Id))
+		return nil, errors.Wrap(err, "get job")
+	}
+
+	out, err := s.jobObject(ctx, job)
+	return out, errors.Wrap(err, "get job")
+}
+
+func (s *JobService) ListJob(ctx context.Context, req *pb.ListJobRequest) (*pb.ListJobResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", zfield.Target(req.Target))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	jobs, err := s.repo.ListJob(ctx, &dao.ListJobReq{Target: req.Target, Limit: req.Limit})
+	if nil != err {
+		log.L().Error("list job", zap.Error(err), zfield.Target(req.Target))
+		return nil, errors.Wrap(err, "list job")
+	}
+
+	out := &pb.ListJobResponse{}
+	for index := range jobs {
+		item, err := s.jobObject(ctx, &jobs[index])
+		if nil != err {
+			return nil, errors.Wrap(err, "list job")
+		}
+		out.Items = append(out.Items, item)
+	}
+	out.Count = int32(len(out.Items))
+
+	return out, nil
+}
+
+// jobObject returns job status, with failures reported by runtimes.
+func (s *JobService) jobObject(ctx context.Context, job *dao.Job) (*pb.JobObject, error) {
+	failures, err := s.repo.ListJobFailure(ctx, job.ID)
+	if nil != err {
+		log.L().Error("list job failure", zap.Error(err), zfield.ID(job.ID))
+		return nil, errors.Wrap(err, "list job failure")
+	}
+
+	out := &pb.JobObject{
+		Id:         job.ID,
+		Type:       job.Type,
+		Target:     job.Target,
+		Status:     job.Status,
+		Total:      job.Total,
+		Dispatched: job.Dispatched,
+		Applied:    job.Applied,
+		Failed:     job.Failed,
+		StartTime:  job.StartTime,
+		EndTime:    job.EndTime,
+	}
+
+	for _, f := range failures {
+		out.Failures = append(out.Failures, &pb.JobFailureObject{
+			EntityId:  f.EntityID,
+			Error:     f.Error,
+			Timestamp: f.Timestamp,
+		})
+	}
+
+	return out, nil
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/core/pkg/runtime/mock"
+)
+
+func Test_GetJob(t *testing.T) {
+	js, err := NewJobService(context.Background())
+	assert.Nil(t, err)
+
+	repo := mock.NewRepo()
+	repo.Jobs["job-1"] = dao.Job{ID: "job-1", Type: dao.JobTypeTemplatePropagate, Target: "sensor",
+		Status: dao.JobStatusFailed, Total: 2, Dispatched: 2, Applied: 1, Failed: 1}
+	repo.JobFailures = []dao.JobFailure{{JobID: "job-1", EntityID: "device123", Error: "Core.Template.Cycle"}}
+	js.Init(repo)
+	res, err := js.GetJob(context.Background(), &pb.GetJobRequest{Id: "job-1"})
+	assert.Nil(t, err)
+	assert.Equal(t, "sensor", res.Target)
+	assert.Equal(t, int64(2), res.Dispatched)
+	assert.Equal(t, int64(1), res.Applied)
+	assert.Equal(t, int64(1), res.Failed)
+	assert.Equal(t, dao.JobStatusFailed, res.Status)
+	assert.Equal(t, "device123", res.Failures[0].EntityId)
+}
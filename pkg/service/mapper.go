@@ -32,6 +32,7 @@ func (s *EntityService) AppendMapper(ctx context.Context, req *pb.AppendMapperRe
 		Owner:       entity.Owner,
 		EntityID:    req.EntityId,
 		Description: req.Mapper.Description,
+		Windows:     windowsFrom(req.Mapper.Windows),
 	}
 
 	// append mapper.
@@ -50,6 +51,7 @@ func (s *EntityService) AppendMapper(ctx context.Context, req *pb.AppendMapperRe
 			Name:        mp.Name,
 			Tql:         mp.TQL,
 			Description: mp.Description,
+			Windows:     windowsTo(mp.Windows),
 		},
 	}, nil
 }
@@ -121,6 +123,7 @@ func (s *EntityService) GetMapper(ctx context.Context, in *pb.GetMapperRequest)
 			Name:        mp.Name,
 			Tql:         mp.TQL,
 			Description: mp.Description,
+			Windows:     windowsTo(mp.Windows),
 		},
 	}, nil
 }
@@ -151,6 +154,7 @@ func (s *EntityService) ListMapper(ctx context.Context, in *pb.ListMapperRequest
 			Name:        mps[index].Name,
 			Tql:         mps[index].TQL,
 			Description: mps[index].Description,
+			Windows:     windowsTo(mps[index].Windows),
 		}
 	}
 
@@ -162,3 +166,37 @@ func (s *EntityService) ListMapper(ctx context.Context, in *pb.ListMapperRequest
 		Mappers:  mpDtos,
 	}, nil
 }
+
+// windowsFrom convert windows of mapper request.
+func windowsFrom(windows []*pb.MapperWindow) []dao.Window {
+	if len(windows) == 0 {
+		return nil
+	}
+
+	ws := make([]dao.Window, len(windows))
+	for index, w := range windows {
+		ws[index] = dao.Window{
+			Input:     w.Input,
+			Type:      w.Type,
+			Size:      w.Size,
+			Aggregate: w.Aggregate,
+			Emit:      w.Emit,
+		}
+	}
+	return ws
+}
+
+// windowsTo convert windows of mapper response.
+func windowsTo(windows []dao.Window) []*pb.MapperWindow {
+	ws := make([]*pb.MapperWindow, len(windows))
+	for index, w := range windows {
+		ws[index] = &pb.MapperWindow{
+			Input:     w.Input,
+			Type:      w.Type,
+			Size:      w.Size,
+			Aggregate: w.Aggregate,
+			Emit:      w.Emit,
+		}
+	}
+	return ws
+}
@@ -2,6 +2,7 @@ package mock
 
 import (
 	"context"
+	"time"
 
 	v1 "github.com/tkeel-io/core/api/core/v1"
 	apim "github.com/tkeel-io/core/pkg/manager"
@@ -23,6 +24,9 @@ func (m *APIManagerMock) Start() error { return nil }
 func (m *APIManagerMock) OnRespond(ctx context.Context, resp *holder.Response) {
 }
 
+// Shutdown wait holding requests responded.
+func (m *APIManagerMock) Shutdown(ctx context.Context) error { return nil }
+
 // CreateEntity create entity.
 func (m *APIManagerMock) CreateEntity(_ context.Context, in *apim.Base) (*apim.BaseRet, error) {
 	return &apim.BaseRet{
@@ -44,10 +48,52 @@ func (m *APIManagerMock) PatchEntity(_ context.Context, in *apim.Base, _ []*v1.P
 }
 
 // DeleteEntity delete entity.
-func (m *APIManagerMock) DeleteEntity(context.Context, *apim.Base) error {
+func (m *APIManagerMock) DeleteEntity(context.Context, *apim.Base, ...apim.Option) error {
 	return nil
 }
 
+// GetEntityAt returns entity at version.
+func (m *APIManagerMock) GetEntityAt(_ context.Context, in *apim.Base, version, _ int64) (*apim.BaseRet, []byte, error) {
+	return &apim.BaseRet{
+		ID:      in.ID,
+		Type:    in.Type,
+		Owner:   in.Owner,
+		Source:  in.Source,
+		Version: version,
+	}, nil, nil
+}
+
+// BatchEntities returns entities of items.
+func (m *APIManagerMock) BatchEntities(_ context.Context, items []*apim.BatchItem) []*apim.BatchResult {
+	results := make([]*apim.BatchResult, len(items))
+	for index, item := range items {
+		results[index] = &apim.BatchResult{}
+		if apim.BatchDelete != item.Op {
+			results[index].Ret = &apim.BaseRet{
+				ID:     item.Entity.ID,
+				Type:   item.Entity.Type,
+				Owner:  item.Entity.Owner,
+				Source: item.Entity.Source,
+			}
+		}
+	}
+	return results
+}
+
+// CommitTransaction returns entities of items.
+func (m *APIManagerMock) CommitTransaction(_ context.Context, items []*apim.TxItem, _ time.Duration) (string, []*apim.TxResult, error) {
+	results := make([]*apim.TxResult, len(items))
+	for index, item := range items {
+		results[index] = &apim.TxResult{Ret: &apim.BaseRet{
+			ID:     item.Entity.ID,
+			Type:   item.Entity.Type,
+			Owner:  item.Entity.Owner,
+			Source: item.Entity.Source,
+		}}
+	}
+	return "tx-mock", results, nil
+}
+
 // GetProperties returns entity properties.
 func (m *APIManagerMock) GetEntity(_ context.Context, in *apim.Base) (*apim.BaseRet, error) {
 	return &apim.BaseRet{
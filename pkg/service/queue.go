@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"sort"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+type QueueService struct {
+	pb.UnimplementedQueueServer
+	ctx    context.Context
+	cancel context.CancelFunc
+	inited *atomic.Bool
+	repo   repository.IRepository
+}
+
+// NewQueueService returns a new QueueService.
+func NewQueueService(ctx context.Context) (*QueueService, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &QueueService{
+		ctx:    ctx,
+		cancel: cancel,
+		inited: atomic.NewBool(false),
+	}, nil
+}
+
+func (s *QueueService) Init(repo repository.IRepository) {
+	s.repo = repo
+	s.inited.Store(true)
+}
+
+func (s *QueueService) CreateQueue(ctx context.Context, req *pb.CreateQueueRequest) (*pb.QueueResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", zfield.ID(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	queue := &dao.Queue{
+		ID:           req.Id,
+		Name:         req.Name,
+		Type:         dao.QueueType(req.Type),
+		NodeName:     req.NodeName,
+		Consumers:    req.Consumers,
+		ConsumerType: dao.ConsumerType(req.ConsumerType),
+		Description:  req.Description,
+		Version:      1,
+		Metadata:     map[string]interface{}{dao.QueueMetaURL: req.Url},
+	}
+	if err := checkQueue(queue); nil != err {
+		log.L().Error("create queue", zap.Error(err), zfield.ID(req.Id))
+		return nil, errors.Wrap(err, "create queue")
+	}
+
+	if _, err := s.repo.GetQueue(ctx, &dao.Queue{ID: req.Id}); nil == err {
+		return nil, errors.Wrap(xerrors.ErrQueueAlreadyExists, "create queue")
+	} else if !errors.Is(err, xerrors.ErrQueueNotFound) {
+		log.L().Error("create queue", zap.Error(err), zfield.ID(req.Id))
+		return nil, errors.Wrap(err, "create queue")
+	}
+
+	if err := s.repo.PutQueue(ctx, queue); nil != err {
+		log.L().Error("create queue", zap.Error(err), zfield.ID(req.Id))
+		return nil, errors.Wrap(err, "create queue")
+	}
+
+	return &pb.QueueResponse{Queue: queueObject(queue)}, nil
+}
+
+func (s *QueueService) UpdateQueue(ctx context.Context, req *pb.UpdateQueueRequest) (*pb.QueueResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", zfield.ID(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	queue, err := s.repo.GetQueue(ctx, &dao.Queue{ID: req.Id})
+	if nil != err {
+		log.L().Error("update queue", zap.Error(err), zfield.ID(req.Id))
+		return nil, errors.Wrap(err, "update queue")
+	}
+
+	queue.Name = req.Name
+	queue.Type = dao.QueueType(req.Type)
+	queue.NodeName = req.NodeName
+	queue.Consumers = req.Consumers
+	queue.ConsumerType = dao.ConsumerType(req.ConsumerType)
+	queue.Description = req.Description
+	if nil == queue.Metadata {
+		queue.Metadata = make(map[string]interface{})
+	}
+	queue.Metadata[dao.QueueMetaURL] = req.Url
+	return s.putQueue(ctx, queue)
+}
+
+func (s *QueueService) DeleteQueue(ctx context.Context, req *pb.DeleteQueueRequest) (*pb.DeleteQueueResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", zfield.ID(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	if err := s.repo.DelQueue(ctx, &dao.Queue{ID: req.Id}); nil != err {
+		log.L().Error("delete queue", zap.Error(err), zfield.ID(req.Id))
+		return nil, errors.Wrap(err, "delete queue")
+	}
+
+	return &pb.DeleteQueueResponse{Id: req.Id}, nil
+}
+
+func (s *QueueService) GetQueue(ctx context.Context, req *pb.GetQueueRequest) (*pb.QueueResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", zfield.ID(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	queue, err := s.repo.GetQueue(ctx, &dao.Queue{ID: req.Id})
+	if nil != err {
+		log.L().Error("get queue", zap.Error(err), zfield.ID(req.Id))
+		return nil, errors.Wrap(err, "get queue")
+	}
+
+	return &pb.QueueResponse{Queue: queueObject(queue)}, nil
+}
+
+func (s *QueueService) ListQueue(ctx context.Context, req *pb.ListQueueRequest) (*pb.ListQueueResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready")
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	out := &pb.ListQueueResponse{}
+	s.repo.RangeQueue(ctx, 0, func(queues []dao.Queue) {
+		for index := range queues {
+			if req.NodeName == "" || req.NodeName == queues[index].NodeName {
+				out.Items = append(out.Items, queueObject(&queues[index]))
+			}
+		}
+	})
+
+	sort.Slice(out.Items, func(i, j int) bool { return out.Items[i].Id < out.Items[j].Id })
+	out.Count = int32(len(out.Items))
+	return out, nil
+}
+
+// AssignQueue reassign queue to node, the runtime of queue moved to the node.
+func (s *QueueService) AssignQueue(ctx context.Context, req *pb.AssignQueueRequest) (*pb.QueueResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", zfield.ID(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	queue, err := s.repo.GetQueue(ctx, &dao.Queue{ID: req.Id})
+	if nil != err {
+		log.L().Error("assign queue", zap.Error(err), zfield.ID(req.Id))
+		return nil, errors.Wrap(err, "assign queue")
+	}
+
+	log.L().Info("assign queue", zfield.ID(req.Id),
+		zap.String("from", queue.NodeName), zap.String("to", req.NodeName))
+	queue.NodeName = req.NodeName
+	return s.putQueue(ctx, queue)
+}
+
+// ListOwner returns nodes owning runtimes, the owner consumes the queue.
+func (s *QueueService) ListOwner(ctx context.Context, req *pb.ListOwnerRequest) (*pb.ListOwnerResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready")
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	owners, err := s.repo.ListOwner(ctx)
+	if nil != err {
+		log.L().Error("list owner", zap.Error(err))
+		return nil, errors.Wrap(err, "list owner")
+	}
+
+	out := &pb.ListOwnerResponse{}
+	for _, owner := range owners {
+		if req.NodeName == "" || req.NodeName == owner.NodeName {
+			out.Items = append(out.Items, &pb.OwnerObject{
+				RuntimeId: owner.RuntimeID,
+				NodeName:  owner.NodeName,
+				Lease:     owner.Lease,
+				Timestamp: owner.Timestamp,
+			})
+		}
+	}
+	out.Count = int32(len(out.Items))
+
+	return out, nil
+}
+
+func (s *QueueService) putQueue(ctx context.Context, queue *dao.Queue) (*pb.QueueResponse, error) {
+	if err := checkQueue(queue); nil != err {
+		log.L().Error("put queue", zap.Error(err), zfield.ID(queue.ID))
+		return nil, errors.Wrap(err, "put queue")
+	}
+
+	queue.Version++
+	if err := s.repo.PutQueue(ctx, queue); nil != err {
+		log.L().Error("put queue", zap.Error(err), zfield.ID(queue.ID))
+		return nil, errors.Wrap(err, "put queue")
+	}
+
+	return &pb.QueueResponse{Queue: queueObject(queue)}, nil
+}
+
+func checkQueue(queue *dao.Queue) error {
+	if queue.ID == "" {
+		return errors.Wrap(xerrors.ErrInvalidParam, "queue id required")
+	} else if err := queue.Check(); nil != err {
+		return errors.Wrap(err, "check queue")
+	}
+
+	urlIns, err := url.Parse(queue.URL())
+	if nil != err || urlIns.Scheme != "kafka" || urlIns.Host == "" {
+		return errors.Wrapf(xerrors.ErrInvalidParam, "invalid queue url %s", queue.URL())
+	}
+	return nil
+}
+
+func queueObject(queue *dao.Queue) *pb.QueueObject {
+	return &pb.QueueObject{
+		Id:           queue.ID,
+		Name:         queue.Name,
+		Type:         queue.Type.String(),
+		Url:          queue.URL(),
+		NodeName:     queue.NodeName,
+		ConsumerType: queue.ConsumerType.String(),
+		Consumers:    queue.Consumers,
+		Description:  queue.Description,
+		Version:      queue.Version,
+	}
+}
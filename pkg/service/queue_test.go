@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	rmock "github.com/tkeel-io/core/pkg/runtime/mock"
+)
+
+func newQueueRepo() *rmock.Repo {
+	repo := rmock.NewRepo()
+	repo.Owners["core0"] = dao.Owner{RuntimeID: "core0", NodeName: "node1", Lease: 1}
+	repo.Owners["core1"] = dao.Owner{RuntimeID: "core1", NodeName: "node2", Lease: 2}
+	return repo
+}
+
+func Test_Queue(t *testing.T) {
+	qs, err := NewQueueService(context.Background())
+	assert.Nil(t, err)
+	qs.Init(newQueueRepo())
+
+	create := &pb.CreateQueueRequest{
+		Id:           "core0",
+		Type:         dao.QueueTypeKafkaTopic.String(),
+		Url:          "kafka://localhost:9092/core0/core",
+		NodeName:     "node1",
+		ConsumerType: dao.ConsumerTypeCore.String(),
+	}
+	out, err := qs.CreateQueue(context.Background(), create)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), out.Queue.Version)
+
+	_, err = qs.CreateQueue(context.Background(), create)
+	assert.True(t, errors.Is(err, xerrors.ErrQueueAlreadyExists))
+
+	create.Id, create.Url = "core1", "http://localhost/core1"
+	_, err = qs.CreateQueue(context.Background(), create)
+	assert.True(t, errors.Is(err, xerrors.ErrInvalidParam))
+
+	create.Url, create.NodeName = "kafka://localhost:9092/core1/core", "node2"
+	_, err = qs.CreateQueue(context.Background(), create)
+	assert.Nil(t, err)
+
+	out, err = qs.AssignQueue(context.Background(), &pb.AssignQueueRequest{Id: "core0", NodeName: "node2"})
+	assert.Nil(t, err)
+	assert.Equal(t, "node2", out.Queue.NodeName)
+	assert.Equal(t, int64(2), out.Queue.Version)
+	assert.Equal(t, "kafka://localhost:9092/core0/core", out.Queue.Url)
+
+	list, err := qs.ListQueue(context.Background(), &pb.ListQueueRequest{NodeName: "node2"})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), list.Count)
+	assert.Equal(t, "core0", list.Items[0].Id)
+
+	_, err = qs.DeleteQueue(context.Background(), &pb.DeleteQueueRequest{Id: "core0"})
+	assert.Nil(t, err)
+	_, err = qs.GetQueue(context.Background(), &pb.GetQueueRequest{Id: "core0"})
+	assert.True(t, errors.Is(err, xerrors.ErrQueueNotFound))
+}
+
+func Test_ListOwner(t *testing.T) {
+	qs, err := NewQueueService(context.Background())
+	assert.Nil(t, err)
+	qs.Init(newQueueRepo())
+
+	out, err := qs.ListOwner(context.Background(), &pb.ListOwnerRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), out.Count)
+
+	out, err = qs.ListOwner(context.Background(), &pb.ListOwnerRequest{NodeName: "node2"})
+	assert.Nil(t, err)
+	assert.Equal(t, "core1", out.Items[0].RuntimeId)
+}
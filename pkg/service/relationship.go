@@ -0,0 +1,388 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	apim "github.com/tkeel-io/core/pkg/manager"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// type of relationship documents in search engine.
+	SMTypeRelationship = "RELATIONSHIP"
+
+	RelationshipOut  = "out"
+	RelationshipIn   = "in"
+	RelationshipBoth = "both"
+
+	maxTraverseDepth = 10
+)
+
+var relationshipTypeRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_\-]*$`)
+
+type RelationshipService struct {
+	pb.UnimplementedRelationshipServer
+	ctx          context.Context
+	cancel       context.CancelFunc
+	inited       *atomic.Bool
+	repo         repository.IRepository
+	apiManager   apim.APIManager
+	searchClient pb.SearchHTTPServer
+}
+
+// NewRelationshipService returns a new RelationshipService.
+func NewRelationshipService(ctx context.Context) (*RelationshipService, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &RelationshipService{
+		ctx:    ctx,
+		cancel: cancel,
+		inited: atomic.NewBool(false),
+	}, nil
+}
+
+func (s *RelationshipService) Init(apiManager apim.APIManager, searchClient pb.SearchHTTPServer, repo repository.IRepository) {
+	s.repo = repo
+	s.apiManager = apiManager
+	s.searchClient = searchClient
+	s.inited.Store(true)
+}
+
+func (s *RelationshipService) CreateRelationship(ctx context.Context, req *pb.CreateRelationshipRequest) (*pb.RelationshipObject, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", zfield.Eid(req.Source))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	if err := checkRelationship(req.Source, req.Type, req.Target); nil != err {
+		return nil, errors.Wrap(err, "create relationship")
+	}
+
+	properties, err := relationshipProperties(req.Properties)
+	if nil != err {
+		return nil, errors.Wrap(err, "create relationship")
+	}
+
+	// both source and target entity must exist.
+	source := &Entity{ID: req.Source}
+	parseHeaderFrom(ctx, source)
+	ret, err := s.apiManager.GetEntity(ctx, source)
+	if nil != err {
+		log.L().Error("create relationship, get source entity", zap.Error(err), zfield.Eid(req.Source))
+		return nil, errors.Wrap(err, "create relationship")
+	}
+
+	target := &Entity{ID: req.Target}
+	parseHeaderFrom(ctx, target)
+	if tret, err := s.apiManager.GetEntity(ctx, target); nil != err {
+		log.L().Error("create relationship, get target entity", zap.Error(err), zfield.Eid(req.Target))
+		return nil, errors.Wrap(err, "create relationship")
+	} else if tret.Owner != ret.Owner {
+		log.L().Warn("create relationship, owner mismatched", zfield.Eid(req.Target), zfield.Owner(tret.Owner))
+		return nil, errors.Wrap(xerrors.ErrEntityNotFound, "create relationship, target entity")
+	}
+
+	rel := &dao.Relationship{Source: req.Source, Type: req.Type, Target: req.Target}
+	if _, err = s.repo.GetRelationship(ctx, rel); nil == err {
+		return nil, errors.Wrap(xerrors.ErrRelationshipExists, "create relationship")
+	} else if !errors.Is(err, xerrors.ErrRelationshipNotFound) {
+		return nil, errors.Wrap(err, "create relationship")
+	}
+
+	now := time.Now().UnixNano()
+	rel = &dao.Relationship{
+		Source:     req.Source,
+		Type:       req.Type,
+		Target:     req.Target,
+		Owner:      ret.Owner,
+		Properties: properties,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	if err = s.putRelationship(ctx, rel); nil != err {
+		return nil, errors.Wrap(err, "create relationship")
+	}
+
+	out, err := relationshipObject(rel)
+	return out, errors.Wrap(err, "create relationship")
+}
+
+func (s *RelationshipService) UpdateRelationship(ctx context.Context, req *pb.UpdateRelationshipRequest) (*pb.RelationshipObject, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", zfield.Eid(req.Source))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	properties, err := relationshipProperties(req.Properties)
+	if nil != err {
+		return nil, errors.Wrap(err, "update relationship")
+	}
+
+	rel, err := s.repo.GetRelationship(ctx,
+		&dao.Relationship{Source: req.Source, Type: req.Type, Target: req.Target})
+	if nil != err {
+		log.L().Error("update relationship", zap.Error(err),
+			zfield.Eid(req.Source), zfield.Target(req.Target), zfield.Type(req.Type))
+		return nil, errors.Wrap(err, "update relationship")
+	}
+
+	rel.Properties = properties
+	rel.UpdatedAt = time.Now().UnixNano()
+	if err = s.putRelationship(ctx, rel); nil != err {
+		return nil, errors.Wrap(err, "update relationship")
+	}
+
+	out, err := relationshipObject(rel)
+	return out, errors.Wrap(err, "update relationship")
+}
+
+func (s *RelationshipService) GetRelationship(ctx context.Context, req *pb.GetRelationshipRequest) (*pb.RelationshipObject, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", zfield.Eid(req.Source))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	rel, err := s.repo.GetRelationship(ctx,
+		&dao.Relationship{Source: req.Source, Type: req.Type, Target: req.Target})
+	if nil != err {
+		log.L().Error("get relationship", zap.Error(err),
+			zfield.Eid(req.Source), zfield.Target(req.Target), zfield.Type(req.Type))
+		return nil, errors.Wrap(err, "get relationship")
+	}
+
+	out, err := relationshipObject(rel)
+	return out, errors.Wrap(err, "get relationship")
+}
+
+func (s *RelationshipService) DeleteRelationship(ctx context.Context, req *pb.DeleteRelationshipRequest) (*pb.DeleteRelationshipResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", zfield.Eid(req.Source))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	// owner of relationship required by search engine.
+	rel, err := s.repo.GetRelationship(ctx,
+		&dao.Relationship{Source: req.Source, Type: req.Type, Target: req.Target})
+	if nil != err {
+		log.L().Error("delete relationship", zap.Error(err),
+			zfield.Eid(req.Source), zfield.Target(req.Target), zfield.Type(req.Type))
+		return nil, errors.Wrap(err, "delete relationship")
+	} else if err = s.repo.DelRelationship(ctx, rel); nil != err {
+		log.L().Error("delete relationship", zap.Error(err),
+			zfield.Eid(req.Source), zfield.Target(req.Target), zfield.Type(req.Type))
+		return nil, errors.Wrap(err, "delete relationship")
+	}
+
+	if _, err = s.searchClient.DeleteByID(ctx, &pb.DeleteByIDRequest{Id: rel.ID(), Owner: rel.Owner}); nil != err {
+		log.L().Warn("delete relationship from search engine", zap.Error(err), zfield.ID(rel.ID()))
+	}
+
+	return &pb.DeleteRelationshipResponse{Source: req.Source, Type: req.Type, Target: req.Target}, nil
+}
+
+// ListRelationship returns neighbours or reverse neighbours of entity.
+func (s *RelationshipService) ListRelationship(ctx context.Context, req *pb.ListRelationshipRequest) (*pb.ListRelationshipResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", zfield.Eid(req.EntityId))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	direction, err := checkDirection(req.Direction)
+	if nil != err {
+		return nil, errors.Wrap(err, "list relationship")
+	}
+
+	relationships, err := s.neighbours(ctx, req.EntityId, req.Type, direction)
+	if nil != err {
+		log.L().Error("list relationship", zap.Error(err), zfield.Eid(req.EntityId))
+		return nil, errors.Wrap(err, "list relationship")
+	}
+
+	out := &pb.ListRelationshipResponse{}
+	for index := range relationships {
+		item, err := relationshipObject(&relationships[index])
+		if nil != err {
+			return nil, errors.Wrap(err, "list relationship")
+		}
+		out.Items = append(out.Items, item)
+	}
+	out.Count = int32(len(out.Items))
+
+	return out, nil
+}
+
+// TraverseRelationship returns entities reached from the entity in N hops.
+func (s *RelationshipService) TraverseRelationship(ctx context.Context, req *pb.TraverseRelationshipRequest) (*pb.TraverseRelationshipResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", zfield.Eid(req.EntityId))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	direction, err := checkDirection(req.Direction)
+	if nil != err {
+		return nil, errors.Wrap(err, "traverse relationship")
+	}
+
+	depth := int(req.Depth)
+	if depth == 0 {
+		depth = 1
+	} else if depth < 0 || depth > maxTraverseDepth {
+		return nil, errors.Wrapf(xerrors.ErrInvalidParam, "depth must be in [1, %d]", maxTraverseDepth)
+	}
+
+	out := &pb.TraverseRelationshipResponse{EntityId: req.EntityId}
+	visited := map[string]bool{req.EntityId: true}
+	traversed := make(map[string]bool)
+	frontier := []string{req.EntityId}
+	for hops := 1; hops <= depth && len(frontier) > 0; hops++ {
+		var next []string
+		for _, eid := range frontier {
+			relationships, err := s.neighbours(ctx, eid, req.Type, direction)
+			if nil != err {
+				log.L().Error("traverse relationship", zap.Error(err), zfield.Eid(eid))
+				return nil, errors.Wrap(err, "traverse relationship")
+			}
+
+			for index := range relationships {
+				rel := &relationships[index]
+				if !traversed[rel.Key()] {
+					traversed[rel.Key()] = true
+					edge, err := relationshipObject(rel)
+					if nil != err {
+						return nil, errors.Wrap(err, "traverse relationship")
+					}
+					out.Edges = append(out.Edges, edge)
+				}
+
+				peer := rel.Target
+				if rel.Target == eid {
+					peer = rel.Source
+				}
+
+				if !visited[peer] {
+					visited[peer] = true
+					next = append(next, peer)
+					out.Nodes = append(out.Nodes, &pb.TraverseNode{EntityId: peer, Depth: int32(hops)})
+				}
+			}
+		}
+		frontier = next
+	}
+
+	return out, nil
+}
+
+func (s *RelationshipService) neighbours(ctx context.Context, eid, typ, direction string) ([]dao.Relationship, error) {
+	var relationships []dao.Relationship
+	if direction != RelationshipIn {
+		items, err := s.repo.ListRelationship(ctx, &dao.ListRelationshipReq{EntityID: eid, Type: typ})
+		if nil != err {
+			return nil, errors.Wrap(err, "list relationship")
+		}
+		relationships = append(relationships, items...)
+	}
+
+	if direction != RelationshipOut {
+		items, err := s.repo.ListRelationship(ctx, &dao.ListRelationshipReq{EntityID: eid, Type: typ, Reverse: true})
+		if nil != err {
+			return nil, errors.Wrap(err, "list reverse relationship")
+		}
+		relationships = append(relationships, items...)
+	}
+
+	return relationships, nil
+}
+
+// putRelationship store relationship and index it for search.
+func (s *RelationshipService) putRelationship(ctx context.Context, rel *dao.Relationship) error {
+	if err := s.repo.PutRelationship(ctx, rel); nil != err {
+		log.L().Error("put relationship", zap.Error(err), zfield.ID(rel.ID()))
+		return errors.Wrap(err, "put relationship")
+	}
+
+	obj, err := structpb.NewValue(map[string]interface{}{
+		"id":                  rel.ID(),
+		"type":                SMTypeRelationship,
+		"owner":               rel.Owner,
+		"relation_type":       rel.Type,
+		"relation_source":     rel.Source,
+		"relation_target":     rel.Target,
+		"relation_properties": rel.Properties,
+		"created_at":          rel.CreatedAt,
+		"updated_at":          rel.UpdatedAt,
+	})
+	if nil != err {
+		log.L().Error("encode relationship index", zap.Error(err), zfield.ID(rel.ID()))
+		return errors.Wrap(err, "encode relationship index")
+	}
+
+	// relationship stored, search index rebuilt when updated.
+	if _, err = s.searchClient.Index(ctx, &pb.IndexObject{Obj: obj}); nil != err {
+		log.L().Warn("index relationship", zap.Error(err), zfield.ID(rel.ID()))
+	}
+	return nil
+}
+
+func checkRelationship(source, typ, target string) error {
+	switch {
+	case strings.TrimSpace(source) == "" || strings.TrimSpace(target) == "":
+		return errors.Wrap(xerrors.ErrInvalidParam, "source and target required")
+	case !relationshipTypeRegexp.MatchString(typ):
+		return errors.Wrapf(xerrors.ErrInvalidParam, "relationship type %s", typ)
+	case source == target:
+		return errors.Wrap(xerrors.ErrInvalidParam, "relationship to itself")
+	}
+	return nil
+}
+
+func checkDirection(direction string) (string, error) {
+	switch direction = strings.ToLower(strings.TrimSpace(direction)); direction {
+	case "":
+		return RelationshipOut, nil
+	case RelationshipOut, RelationshipIn, RelationshipBoth:
+		return direction, nil
+	default:
+		return "", errors.Wrapf(xerrors.ErrInvalidParam, "direction %s", direction)
+	}
+}
+
+func relationshipProperties(val *structpb.Value) (map[string]interface{}, error) {
+	switch properties := val.AsInterface().(type) {
+	case map[string]interface{}:
+		return properties, nil
+	case nil:
+		return nil, nil
+	default:
+		return nil, errors.Wrap(xerrors.ErrInvalidParam, "relationship properties must be object")
+	}
+}
+
+func relationshipObject(rel *dao.Relationship) (*pb.RelationshipObject, error) {
+	properties, err := structpb.NewValue(rel.Properties)
+	if nil != err {
+		return nil, errors.Wrap(err, "convert relationship properties")
+	}
+
+	return &pb.RelationshipObject{
+		Source:     rel.Source,
+		Type:       rel.Type,
+		Target:     rel.Target,
+		Owner:      rel.Owner,
+		Properties: properties,
+		CreatedAt:  rel.CreatedAt,
+		UpdatedAt:  rel.UpdatedAt,
+	}, nil
+}
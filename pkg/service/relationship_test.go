@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	rmock "github.com/tkeel-io/core/pkg/runtime/mock"
+	"github.com/tkeel-io/core/pkg/service/mock"
+)
+
+func newRelationshipService(t *testing.T) *RelationshipService {
+	rs, err := NewRelationshipService(context.Background())
+	assert.Nil(t, err)
+	rs.Init(mock.NewAPIManagerMock(), mock.NewSearchMock(),
+		rmock.NewRepo())
+	return rs
+}
+
+func Test_CreateRelationship(t *testing.T) {
+	rs := newRelationshipService(t)
+	ctx := context.Background()
+
+	res, err := rs.CreateRelationship(ctx, &pb.CreateRelationshipRequest{Source: "room", Type: "contains", Target: "device"})
+	assert.Nil(t, err)
+	assert.Equal(t, "contains", res.Type)
+
+	_, err = rs.CreateRelationship(ctx, &pb.CreateRelationshipRequest{Source: "room", Type: "contains", Target: "device"})
+	assert.ErrorIs(t, err, xerrors.ErrRelationshipExists)
+
+	_, err = rs.CreateRelationship(ctx, &pb.CreateRelationshipRequest{Source: "room", Type: "bad type", Target: "device"})
+	assert.ErrorIs(t, err, xerrors.ErrInvalidParam)
+
+	_, err = rs.DeleteRelationship(ctx, &pb.DeleteRelationshipRequest{Source: "room", Type: "contains", Target: "device"})
+	assert.Nil(t, err)
+	_, err = rs.GetRelationship(ctx, &pb.GetRelationshipRequest{Source: "room", Type: "contains", Target: "device"})
+	assert.ErrorIs(t, err, xerrors.ErrRelationshipNotFound)
+	_, err = rs.DeleteRelationship(ctx, &pb.DeleteRelationshipRequest{Source: "room", Type: "contains", Target: "device"})
+	assert.ErrorIs(t, err, xerrors.ErrRelationshipNotFound)
+
+	// search document id never contains '/' of entity ids.
+	rel := &dao.Relationship{Source: "site/room", Type: "contains", Target: "device"}
+	assert.NotContains(t, rel.ID(), "/")
+	assert.NotEqual(t, rel.ID(), (&dao.Relationship{Source: "site", Type: "room", Target: "contains/device"}).ID())
+}
+
+func Test_TraverseRelationship(t *testing.T) {
+	rs := newRelationshipService(t)
+	ctx := context.Background()
+
+	for _, edge := range []string{"site/contains/building", "building/contains/room", "room/contains/device", "device/feeds/room"} {
+		segs := strings.Split(edge, "/")
+		_, err := rs.CreateRelationship(ctx, &pb.CreateRelationshipRequest{Source: segs[0], Type: segs[1], Target: segs[2]})
+		assert.Nil(t, err)
+	}
+
+	res, err := rs.ListRelationship(ctx, &pb.ListRelationshipRequest{EntityId: "room", Direction: RelationshipBoth})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(3), res.Count)
+
+	out, err := rs.TraverseRelationship(ctx, &pb.TraverseRelationshipRequest{EntityId: "site", Type: "contains", Depth: 2})
+	assert.Nil(t, err)
+	assert.Len(t, out.Nodes, 2)
+	assert.Equal(t, "room", out.Nodes[1].EntityId)
+	assert.Equal(t, int32(2), out.Nodes[1].Depth)
+
+	_, err = rs.TraverseRelationship(ctx, &pb.TraverseRelationshipRequest{EntityId: "site", Depth: maxTraverseDepth + 1})
+	assert.ErrorIs(t, err, xerrors.ErrInvalidParam)
+}
@@ -19,6 +19,8 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -27,22 +29,25 @@ import (
 	zfield "github.com/tkeel-io/core/pkg/logger"
 	apim "github.com/tkeel-io/core/pkg/manager"
 	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/core/pkg/runtime"
 	"github.com/tkeel-io/core/pkg/util"
 	xjson "github.com/tkeel-io/core/pkg/util/json"
 	"github.com/tkeel-io/kit/log"
 	"github.com/tkeel-io/tdtl"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 const SMTypeSubscription = "SUBSCRIPTION"
 
 type SubscriptionService struct {
 	pb.UnimplementedSubscriptionServer
-	ctx        context.Context
-	cancel     context.CancelFunc
-	inited     *atomic.Bool
-	apiManager apim.APIManager
+	ctx          context.Context
+	cancel       context.CancelFunc
+	inited       *atomic.Bool
+	apiManager   apim.APIManager
+	searchClient pb.SearchHTTPServer
 }
 
 // NewSubscriptionService returns a new SubscriptionService.
@@ -56,8 +61,9 @@ func NewSubscriptionService(ctx context.Context) (*SubscriptionService, error) {
 	}, nil
 }
 
-func (s *SubscriptionService) Init(apiManager apim.APIManager) {
+func (s *SubscriptionService) Init(apiManager apim.APIManager, searchClient pb.SearchHTTPServer) {
 	s.apiManager = apiManager
+	s.searchClient = searchClient
 	s.inited.Store(true)
 }
 
@@ -73,6 +79,36 @@ func interface2string(in interface{}) (out string) {
 	return
 }
 
+func interface2int64(in interface{}) (out int64) {
+	switch val := in.(type) {
+	case int64:
+		out = val
+	case float64:
+		out = int64(val)
+	case json.Number:
+		out, _ = val.Int64()
+	case string:
+		out, _ = strconv.ParseInt(val, 10, 64)
+	case tdtl.Node:
+		out, _ = strconv.ParseInt(val.String(), 10, 64)
+	default:
+		out = 0
+	}
+	return
+}
+
+// checkSubscription validate subscription params.
+func checkSubscription(sub *pb.SubscriptionObject) error {
+	if nil == sub {
+		return errors.Wrap(xerrors.ErrInvalidParam, "subscription required")
+	}
+
+	if strings.ToUpper(sub.Mode) == runtime.SModePeriod.S() && sub.Interval <= 0 {
+		return errors.Wrap(xerrors.ErrInvalidParam, "interval of PERIOD subscription must be positive")
+	}
+	return nil
+}
+
 func (s *SubscriptionService) entity2SubscriptionResponse(base *apim.BaseRet) (out *pb.SubscriptionResponse) {
 	if base == nil {
 		return
@@ -90,16 +126,40 @@ func (s *SubscriptionService) entity2SubscriptionResponse(base *apim.BaseRet) (o
 		Target:     interface2string(base.Properties["target"]),
 		Topic:      interface2string(base.Properties["topic"]),
 		PubsubName: interface2string(base.Properties["pubsub_name"]),
+		Interval:   interface2int64(base.Properties["interval"]),
 	}
 	return out
 }
 
+// document2SubscriptionResponse convert search document of subscription, properties tiled.
+func document2SubscriptionResponse(kv map[string]interface{}) *pb.SubscriptionResponse {
+	return &pb.SubscriptionResponse{
+		Id:     interface2string(kv["id"]),
+		Owner:  interface2string(kv["owner"]),
+		Source: interface2string(kv["source"]),
+		Subscription: &pb.SubscriptionObject{
+			Mode:       interface2string(kv["mode"]),
+			Source:     interface2string(kv["source"]),
+			Filter:     interface2string(kv["filter"]),
+			Target:     interface2string(kv["target"]),
+			Topic:      interface2string(kv["topic"]),
+			PubsubName: interface2string(kv["pubsub_name"]),
+			Interval:   interface2int64(kv["interval"]),
+		},
+	}
+}
+
 func (s *SubscriptionService) CreateSubscription(ctx context.Context, req *pb.CreateSubscriptionRequest) (out *pb.SubscriptionResponse, err error) {
 	if !s.inited.Load() {
 		log.L().Warn("service not ready", zfield.Eid(req.Id))
 		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
 	}
 
+	if err = checkSubscription(req.Subscription); nil != err {
+		log.L().Error("create subscription", zap.Error(err), zfield.Eid(req.Id))
+		return nil, errors.Wrap(err, "create subscription")
+	}
+
 	var entity = new(Entity)
 	if req.Id == "" {
 		req.Id = util.UUID("sub")
@@ -118,6 +178,7 @@ func (s *SubscriptionService) CreateSubscription(ctx context.Context, req *pb.Cr
 		"topic":       req.Subscription.Topic,
 		"filter":      req.Subscription.Filter,
 		"pubsub_name": req.Subscription.PubsubName,
+		"interval":    req.Subscription.Interval,
 	}
 
 	if entity.Properties, err = json.Marshal(properties); nil != err {
@@ -160,6 +221,11 @@ func (s *SubscriptionService) UpdateSubscription(ctx context.Context, req *pb.Up
 		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
 	}
 
+	if err = checkSubscription(req.Subscription); nil != err {
+		log.L().Error("update subscription", zap.Error(err), zfield.Eid(req.Id))
+		return nil, errors.Wrap(err, "update subscription")
+	}
+
 	var entity = new(Entity)
 
 	entity.ID = req.Id
@@ -172,6 +238,7 @@ func (s *SubscriptionService) UpdateSubscription(ctx context.Context, req *pb.Up
 		"topic":       req.Subscription.Topic,
 		"filter":      req.Subscription.Filter,
 		"pubsub_name": req.Subscription.PubsubName,
+		"interval":    req.Subscription.Interval,
 	}
 
 	if entity.Properties, err = json.Marshal(properties); nil != err {
@@ -260,5 +327,69 @@ func (s *SubscriptionService) ListSubscription(ctx context.Context, req *pb.List
 		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
 	}
 
-	return &pb.ListSubscriptionResponse{}, nil
+	var entity = new(Entity)
+	entity.Owner = req.Owner
+	entity.Source = req.Source
+	parseHeaderFrom(ctx, entity)
+
+	// subscription properties are tiled in search engine.
+	searchReq := &pb.SearchRequest{
+		Owner:    entity.Owner,
+		Source:   entity.Source,
+		PageNum:  req.PageNum,
+		PageSize: req.PageSize,
+		Condition: eqConditions(map[string]string{
+			"type":        SMTypeSubscription,
+			"owner":       entity.Owner,
+			"source":      entity.Source,
+			"mode":        strings.ToUpper(req.Mode),
+			"topic":       req.Topic,
+			"pubsub_name": req.PubsubName,
+		}),
+	}
+
+	var resp *pb.SearchResponse
+	if resp, err = s.searchClient.Search(ctx, searchReq); nil != err {
+		log.L().Error("list subscription", zap.Error(err), zfield.Owner(entity.Owner))
+		return nil, errors.Wrap(err, "list subscription")
+	}
+
+	out = &pb.ListSubscriptionResponse{
+		Total:    int32(resp.Total),
+		PageNum:  resp.PageNum,
+		PageSize: resp.PageSize,
+	}
+	// subscriptions built from documents, no entity loaded.
+	for _, item := range resp.Items {
+		kv, ok := item.AsInterface().(map[string]interface{})
+		if !ok {
+			log.L().Warn("list subscription, invalid document", zfield.Owner(entity.Owner))
+			continue
+		}
+		out.Items = append(out.Items, document2SubscriptionResponse(kv))
+	}
+	out.Count = int32(len(out.Items))
+
+	return out, nil
+}
+
+// eqConditions returns $eq search conditions of non-empty values.
+func eqConditions(fields map[string]string) []*pb.SearchCondition {
+	keys := make([]string, 0, len(fields))
+	for key, val := range fields {
+		if val != "" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	conds := make([]*pb.SearchCondition, 0, len(keys))
+	for _, key := range keys {
+		conds = append(conds, &pb.SearchCondition{
+			Field:    key,
+			Operator: "$eq",
+			Value:    structpb.NewStringValue(fields[key]),
+		})
+	}
+	return conds
 }
@@ -6,6 +6,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/service/mock"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func Test_NewSubscriptionService(t *testing.T) {
@@ -17,7 +20,7 @@ func Test_CreateSubscription(t *testing.T) {
 	ss, err := NewSubscriptionService(context.Background())
 	assert.Nil(t, err)
 
-	ss.Init(apiManager)
+	ss.Init(apiManager, mock.NewSearchMock())
 	res, err := ss.CreateSubscription(context.Background(), &pb.CreateSubscriptionRequest{
 		Id:     "sub123",
 		Source: "dm",
@@ -37,11 +40,45 @@ func Test_CreateSubscription(t *testing.T) {
 	assert.Equal(t, "dm", res.Source)
 }
 
+func Test_CreatePeriodSubscription(t *testing.T) {
+	ss, err := NewSubscriptionService(context.Background())
+	assert.Nil(t, err)
+
+	ss.Init(apiManager, mock.NewSearchMock())
+	_, err = ss.CreateSubscription(context.Background(), &pb.CreateSubscriptionRequest{
+		Id:     "sub123",
+		Source: "dm",
+		Owner:  "admin",
+		Subscription: &pb.SubscriptionObject{
+			Mode:       "period",
+			Filter:     "insert into sub123 select device123.*",
+			Topic:      "sub123-device123",
+			PubsubName: "sub123",
+		},
+	})
+	assert.ErrorIs(t, err, xerrors.ErrInvalidParam)
+
+	res, err := ss.CreateSubscription(context.Background(), &pb.CreateSubscriptionRequest{
+		Id:     "sub123",
+		Source: "dm",
+		Owner:  "admin",
+		Subscription: &pb.SubscriptionObject{
+			Mode:       "period",
+			Filter:     "insert into sub123 select device123.*",
+			Topic:      "sub123-device123",
+			PubsubName: "sub123",
+			Interval:   10,
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "sub123", res.Id)
+}
+
 func Test_UpdateSubscription(t *testing.T) {
 	ss, err := NewSubscriptionService(context.Background())
 	assert.Nil(t, err)
 
-	ss.Init(apiManager)
+	ss.Init(apiManager, mock.NewSearchMock())
 	res, err := ss.UpdateSubscription(context.Background(), &pb.UpdateSubscriptionRequest{
 		Id:     "sub123",
 		Source: "dm",
@@ -65,7 +102,7 @@ func Test_DeleteSubscription(t *testing.T) {
 	ss, err := NewSubscriptionService(context.Background())
 	assert.Nil(t, err)
 
-	ss.Init(apiManager)
+	ss.Init(apiManager, mock.NewSearchMock())
 	res, err := ss.DeleteSubscription(context.Background(), &pb.DeleteSubscriptionRequest{
 		Id:     "sub123",
 		Source: "dm",
@@ -81,7 +118,7 @@ func Test_GetSubscription(t *testing.T) {
 	ss, err := NewSubscriptionService(context.Background())
 	assert.Nil(t, err)
 
-	ss.Init(apiManager)
+	ss.Init(apiManager, mock.NewSearchMock())
 	res, err := ss.GetSubscription(context.Background(), &pb.GetSubscriptionRequest{
 		Id:     "sub123",
 		Source: "dm",
@@ -91,3 +128,44 @@ func Test_GetSubscription(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, "sub123", res.Id)
 }
+
+type subscriptionSearch struct {
+	pb.SearchHTTPServer
+	req *pb.SearchRequest
+}
+
+func (s *subscriptionSearch) Search(_ context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
+	s.req = req
+	item, _ := structpb.NewValue(map[string]interface{}{
+		"id": "sub123", "type": SMTypeSubscription, "owner": "admin", "source": "dm", "mode": "REALTIME",
+		"topic": "sub-topic", "pubsub_name": "client-pubsub", "interval": float64(0)})
+	return &pb.SearchResponse{Total: 1, PageNum: req.PageNum, PageSize: req.PageSize, Items: []*structpb.Value{item}}, nil
+}
+
+func Test_ListSubscription(t *testing.T) {
+	ss, err := NewSubscriptionService(context.Background())
+	assert.Nil(t, err)
+
+	search := &subscriptionSearch{SearchHTTPServer: mock.NewSearchMock()}
+	ss.Init(apiManager, search)
+	res, err := ss.ListSubscription(context.Background(), &pb.ListSubscriptionRequest{
+		Owner:    "admin",
+		Mode:     "realtime",
+		PageNum:  2,
+		PageSize: 10,
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), res.Total)
+	assert.Equal(t, int32(1), res.Count)
+	assert.Equal(t, int32(2), res.PageNum)
+	assert.Equal(t, "sub123", res.Items[0].Id)
+	assert.Equal(t, "REALTIME", res.Items[0].Subscription.Mode)
+	assert.Equal(t, "sub-topic", res.Items[0].Subscription.Topic)
+
+	conds := map[string]string{}
+	for _, cond := range search.req.Condition {
+		conds[cond.Field] = cond.Value.GetStringValue()
+	}
+	assert.Equal(t, map[string]string{"type": SMTypeSubscription, "owner": "admin", "mode": "REALTIME"}, conds)
+}
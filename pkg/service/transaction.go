@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	apim "github.com/tkeel-io/core/pkg/manager"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/zap"
+)
+
+const (
+	// max entities of transaction.
+	maxTxItems = 100
+	// prepare timeout of transaction, shorter than entity lock timeout of runtime.
+	defaultTxTimeout = 10 * time.Second
+	maxTxTimeout     = 30 * time.Second
+)
+
+// CommitTransaction patch properties of entities all-or-nothing.
+func (s *EntityService) CommitTransaction(ctx context.Context, req *pb.CommitTransactionRequest) (*pb.CommitTransactionResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready")
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	timeout, err := checkTransaction(req)
+	if nil != err {
+		log.L().Error("commit transaction", zap.Error(err))
+		return nil, errors.Wrap(err, "commit transaction")
+	}
+
+	items := make([]*apim.TxItem, len(req.Items))
+	for index, item := range req.Items {
+		entity := &Entity{ID: item.Id, Type: item.Type, Owner: item.Owner, Source: item.Source}
+		parseHeaderFrom(ctx, entity)
+		patches, err := parsePatches(item.Id, item.Properties)
+		if nil != err {
+			return nil, errors.Wrapf(err, "commit transaction, entity %s", item.Id)
+		}
+		items[index] = &apim.TxItem{Entity: entity, Patches: patches, ExpectedVersion: item.ExpectedVersion}
+	}
+
+	txID, results, err := s.apiManager.CommitTransaction(ctx, items, timeout)
+	if nil != err {
+		return nil, withStatus(errors.Wrap(err, "commit transaction"))
+	}
+
+	out := &pb.CommitTransactionResponse{Id: txID}
+	for index, res := range results {
+		var entity *pb.EntityResponse
+		if nil == res.Err {
+			// clip copy properties.
+			if properties, cpflag, innerErr := CopyFrom(res.Raw, items[index].Patches...); nil != innerErr {
+				log.L().Warn("commit transaction", zfield.Eid(req.Items[index].Id), zfield.Reason(innerErr.Error()))
+			} else if cpflag {
+				res.Ret.Properties = properties
+			}
+			entity, res.Err = s.makeResponse(res.Ret)
+		}
+		out.Items = append(out.Items, batchResult(req.Items[index].Id, entity, res.Err))
+	}
+
+	return out, nil
+}
+
+// checkTransaction returns prepare timeout of transaction.
+func checkTransaction(req *pb.CommitTransactionRequest) (time.Duration, error) {
+	if len(req.Items) == 0 || len(req.Items) > maxTxItems {
+		return 0, errors.Wrapf(xerrors.ErrInvalidParam, "items of transaction must be in [1, %d]", maxTxItems)
+	}
+
+	ids := make(map[string]struct{}, len(req.Items))
+	for _, item := range req.Items {
+		if item.Id == "" {
+			return 0, errors.Wrap(xerrors.ErrInvalidParam, "entity id required")
+		} else if _, has := ids[item.Id]; has {
+			return 0, errors.Wrapf(xerrors.ErrInvalidParam, "entity %s duplicated", item.Id)
+		}
+		ids[item.Id] = struct{}{}
+	}
+
+	timeout := time.Duration(req.Timeout) * time.Millisecond
+	if req.Timeout == 0 {
+		timeout = defaultTxTimeout
+	} else if req.Timeout < 0 || timeout > maxTxTimeout {
+		return 0, errors.Wrapf(xerrors.ErrInvalidParam, "timeout must be in [1, %d] ms", maxTxTimeout.Milliseconds())
+	}
+
+	return timeout, nil
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test_CommitTransaction(t *testing.T) {
+	patches, err := structpb.NewValue([]interface{}{
+		map[string]interface{}{"operator": "replace", "path": "gateway", "value": "gw2"}})
+	assert.Nil(t, err)
+
+	out, err := entityService.CommitTransaction(context.Background(), &pb.CommitTransactionRequest{
+		Items: []*pb.PatchEntityPropsRequest{
+			{Id: "gw1", Owner: "admin", Properties: patches},
+			{Id: "device1", Owner: "admin", Properties: patches},
+		}})
+	assert.Nil(t, err)
+	assert.Equal(t, "tx-mock", out.Id)
+	assert.Len(t, out.Items, 2)
+	assert.Equal(t, "device1", out.Items[1].Entity.Id)
+
+	_, err = entityService.CommitTransaction(context.Background(), &pb.CommitTransactionRequest{
+		Items: []*pb.PatchEntityPropsRequest{
+			{Id: "gw1", Owner: "admin", Properties: patches},
+			{Id: "gw1", Owner: "admin", Properties: patches},
+		}})
+	assert.ErrorIs(t, err, xerrors.ErrInvalidParam)
+
+	_, err = entityService.CommitTransaction(context.Background(), &pb.CommitTransactionRequest{
+		Items:   []*pb.PatchEntityPropsRequest{{Id: "gw1", Owner: "admin", Properties: patches}},
+		Timeout: maxTxTimeout.Milliseconds() + 1})
+	assert.ErrorIs(t, err, xerrors.ErrInvalidParam)
+
+	_, err = entityService.CommitTransaction(context.Background(), &pb.CommitTransactionRequest{})
+	assert.ErrorIs(t, err, xerrors.ErrInvalidParam)
+}
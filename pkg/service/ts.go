@@ -230,6 +230,7 @@ func Entity2EntityResponse(base *apim.BaseRet) (out *pb.EntityResponse, err erro
 				Name:        mDesc.Name,
 				Tql:         mDesc.Tql,
 				Description: mDesc.Description,
+				Windows:     mDesc.Windows,
 			})
 	}
 
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 
 	apim "github.com/tkeel-io/core/pkg/manager"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
 )
 
 type Entity = apim.Base
@@ -31,6 +32,7 @@ const (
 	HeaderType        = "Type"
 	HeaderMetadata    = "Metadata"
 	HeaderContentType = "Content-Type"
+	HeaderIfMatch     = "If-Match"
 	QueryType         = "type"
 
 	Plugin = "plugin"
@@ -55,7 +57,18 @@ func NewMarField(v interface{}) MarshalField {
 }
 
 type PatchData struct {
+	Op       string
 	Path     string
+	From     string
 	Operator string
 	Value    interface{}
 }
+
+// normalize accept RFC 6902 json patch document.
+func (pd *PatchData) normalize() {
+	if pd.Operator == "" {
+		pd.Operator = pd.Op
+	}
+	pd.Path = xjson.NormalizePath(pd.Path)
+	pd.From = xjson.NormalizePath(pd.From)
+}
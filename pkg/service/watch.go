@@ -0,0 +1,378 @@
+package service
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	zfield "github.com/tkeel-io/core/pkg/logger"
+	apim "github.com/tkeel-io/core/pkg/manager"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+const (
+	// histories buffered by the shared watcher, watchers resumed from the buffer.
+	watchBufferSize = 1024
+	// histories queued for each watcher, slow watchers dropped.
+	watchQueueSize = 256
+)
+
+// errWatchCaughtUp watcher resumed catch up with histories buffered.
+var errWatchCaughtUp = errors.New("watch caught up")
+
+type WatchService struct {
+	pb.UnimplementedWatchServer
+	ctx    context.Context
+	cancel context.CancelFunc
+	inited *atomic.Bool
+	repo   repository.IRepository
+	hub    *historyHub
+}
+
+// NewWatchService returns a new WatchService.
+func NewWatchService(ctx context.Context) (*WatchService, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &WatchService{
+		ctx:    ctx,
+		cancel: cancel,
+		inited: atomic.NewBool(false),
+	}, nil
+}
+
+func (s *WatchService) Init(repo repository.IRepository) {
+	s.repo = repo
+	s.hub = newHistoryHub(repo)
+	s.inited.Store(true)
+}
+
+// WatchEntities stream changes of entities selected by request, resumable with token.
+func (s *WatchService) WatchEntities(req *pb.WatchEntitiesRequest, stream pb.Watch_WatchEntitiesServer) error {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready")
+		return errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	ctx := stream.Context()
+	base := &apim.Base{Owner: req.Owner}
+	parseHeaderFrom(ctx, base)
+	if base.Owner == "" {
+		log.L().Error("watch entities, owner required")
+		return errors.Wrap(xerrors.ErrInvalidParam, "owner required")
+	}
+
+	// watch from now on if not resumed.
+	after := int64(-1)
+	if req.ResumeToken != "" {
+		rev, err := strconv.ParseInt(req.ResumeToken, 10, 64)
+		if nil != err || rev < 0 {
+			log.L().Error("watch entities, invalid resume token", zap.String("token", req.ResumeToken))
+			return errors.Wrap(xerrors.ErrInvalidParam, "invalid resume token")
+		}
+		after = rev
+	}
+
+	selector := newWatchSelector(req.Ids, req.Paths, req.Type, base.Owner)
+	send := func(rev int64, h dao.History) error {
+		changes, err := selector.match(&h)
+		if nil != err {
+			log.L().Warn("watch entities, invalid history", zap.Error(err),
+				zfield.Eid(h.EntityID), zap.Int64("version", h.Version))
+			return nil
+		} else if len(changes) == 0 {
+			return nil
+		}
+
+		return errors.Wrap(stream.Send(&pb.WatchEntitiesResponse{
+			EntityId:    h.EntityID,
+			Type:        h.EntityType,
+			Owner:       h.EntityOwner,
+			Version:     h.Version,
+			Timestamp:   h.Timestamp,
+			Changes:     changes,
+			ResumeToken: strconv.FormatInt(rev, 10),
+		}), "send change")
+	}
+
+	s.hub.start(s.ctx)
+	var queue chan *watchEvent
+	for {
+		if after >= 0 && !s.hub.covers(after) {
+			// resumed before histories buffered, catch up alone.
+			err := s.repo.WatchHistory(ctx, after, func(rev int64, h dao.History) error {
+				if err := send(rev, h); nil != err {
+					return err
+				}
+				if after = rev; s.hub.covers(after) {
+					return errWatchCaughtUp
+				}
+				return nil
+			})
+			if !errors.Is(err, errWatchCaughtUp) {
+				return watchError(err)
+			}
+		}
+
+		var ok bool
+		if queue, ok = s.hub.subscribe(after); ok {
+			break
+		}
+	}
+
+	defer s.hub.unsubscribe(queue)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev, ok := <-queue:
+			if !ok {
+				log.L().Warn("watch entities, watcher dropped", zap.Int64("revision", after))
+				return errors.Wrap(xerrors.ErrWatcherDropped, "watch entities")
+			}
+			if err := send(ev.rev, ev.history); nil != err {
+				return watchError(err)
+			}
+			after = ev.rev
+		}
+	}
+}
+
+func watchError(err error) error {
+	// client gone away.
+	if errors.Is(err, context.Canceled) {
+		return nil
+	} else if nil != err {
+		log.L().Error("watch entities", zap.Error(err))
+	}
+
+	return errors.Wrap(err, "watch entities")
+}
+
+type watchEvent struct {
+	rev     int64
+	history dao.History
+}
+
+// historyHub share one watch of histories among watchers, buffer recent histories for resuming.
+type historyHub struct {
+	repo    repository.IRepository
+	started bool
+	covered int64 // histories after covered revision buffered.
+	latest  int64
+	events  []*watchEvent
+	queues  map[chan *watchEvent]struct{}
+	lock    sync.Mutex
+}
+
+func newHistoryHub(repo repository.IRepository) *historyHub {
+	return &historyHub{repo: repo, queues: make(map[chan *watchEvent]struct{})}
+}
+
+// start watch histories once, until ctx done.
+func (h *historyHub) start(ctx context.Context) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if h.started {
+		return
+	}
+
+	h.started = true
+	rev := h.repo.GetLastRevision(ctx)
+	h.reset(rev)
+	go h.run(ctx, rev)
+}
+
+func (h *historyHub) run(ctx context.Context, rev int64) {
+	for {
+		err := h.repo.WatchHistory(ctx, rev, func(r int64, history dao.History) error {
+			h.publish(&watchEvent{rev: r, history: history})
+			rev = r
+			return nil
+		})
+
+		if nil == ctx.Err() {
+			log.L().Warn("watch history, rewatch", zap.Error(err), zap.Int64("revision", rev))
+			if errors.Is(err, xerrors.ErrWatchTokenExpired) {
+				// histories compacted, watchers resume from now on.
+				h.lock.Lock()
+				h.reset(0)
+				h.lock.Unlock()
+				rev = 0
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			h.lock.Lock()
+			h.reset(0)
+			h.lock.Unlock()
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// reset drop buffered histories and watchers, unknown revision covered until histories received.
+func (h *historyHub) reset(rev int64) {
+	h.covered, h.latest, h.events = rev, rev, nil
+	if rev == 0 {
+		h.covered = math.MaxInt64
+	}
+	for queue := range h.queues {
+		close(queue)
+		delete(h.queues, queue)
+	}
+}
+
+func (h *historyHub) publish(ev *watchEvent) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if h.covered == math.MaxInt64 {
+		h.covered = ev.rev - 1
+	}
+
+	h.latest = ev.rev
+	h.events = append(h.events, ev)
+	if len(h.events) > watchBufferSize {
+		h.covered = h.events[0].rev
+		h.events = h.events[1:]
+	}
+
+	for queue := range h.queues {
+		select {
+		case queue <- ev:
+		default:
+			close(queue)
+			delete(h.queues, queue)
+		}
+	}
+}
+
+// covers returns true if all histories after revision buffered.
+func (h *historyHub) covers(after int64) bool {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	return after >= h.covered
+}
+
+// subscribe returns queue of histories after revision, or from now on if negative.
+func (h *historyHub) subscribe(after int64) (chan *watchEvent, bool) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if after < 0 {
+		after = h.latest
+	} else if after < h.covered {
+		return nil, false
+	}
+
+	queue := make(chan *watchEvent, watchQueueSize+len(h.events))
+	for _, ev := range h.events {
+		if ev.rev > after {
+			queue <- ev
+		}
+	}
+	h.queues[queue] = struct{}{}
+	return queue, true
+}
+
+func (h *historyHub) unsubscribe(queue chan *watchEvent) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if _, has := h.queues[queue]; has {
+		close(queue)
+		delete(h.queues, queue)
+	}
+}
+
+type watchSelector struct {
+	ids   map[string]struct{}
+	paths []string
+	typ   string
+	owner string
+}
+
+func newWatchSelector(ids, paths, typ, owner string) *watchSelector {
+	selector := &watchSelector{typ: typ, owner: owner}
+	if items := splitWatchItems(ids); len(items) > 0 {
+		selector.ids = make(map[string]struct{}, len(items))
+		for _, id := range items {
+			selector.ids[id] = struct{}{}
+		}
+	}
+	selector.paths = splitWatchItems(paths)
+	return selector
+}
+
+// match returns changes of history which selected.
+func (w *watchSelector) match(h *dao.History) ([]*pb.HistoryPatch, error) {
+	if w.ids != nil {
+		if _, has := w.ids[h.EntityID]; !has {
+			return nil, nil
+		}
+	}
+	if (w.typ != "" && w.typ != h.EntityType) ||
+		(w.owner != "" && w.owner != h.EntityOwner) {
+		return nil, nil
+	}
+
+	changes := make([]*pb.HistoryPatch, 0, len(h.Patches))
+	for _, patch := range h.Patches {
+		if !w.matchPath(patch.Path) {
+			continue
+		}
+		change, err := historyPatch(patch)
+		if nil != err {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// matchPath returns true if path under any prefix, or contains any prefix.
+func (w *watchSelector) matchPath(path string) bool {
+	if len(w.paths) == 0 {
+		return true
+	}
+
+	for _, prefix := range w.paths {
+		if underPath(path, prefix) || underPath(prefix, path) {
+			return true
+		}
+	}
+	return false
+}
+
+func underPath(path, prefix string) bool {
+	if !strings.HasPrefix(path, prefix) {
+		return false
+	} else if len(path) == len(prefix) {
+		return true
+	}
+
+	switch path[len(prefix)] {
+	case '.', '[':
+		return true
+	}
+	return false
+}
+
+func splitWatchItems(s string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	go_restful "github.com/emicklei/go-restful"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	kerrors "github.com/tkeel-io/kit/errors"
+	"github.com/tkeel-io/kit/result"
+	transportHTTP "github.com/tkeel-io/kit/transport/http"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// interval of keepalive comments, keep idle connections from closed by proxies.
+const sseKeepaliveInterval = 15 * time.Second
+
+// watchHTTPHandler serve server-streaming rpc of WatchServer as Server-Sent Events.
+type watchHTTPHandler struct {
+	srv pb.WatchServer
+}
+
+func (h *watchHTTPHandler) WatchEntities(req *go_restful.Request, resp *go_restful.Response) {
+	in := pb.WatchEntitiesRequest{}
+	if err := transportHTTP.GetQuery(req, &in); err != nil {
+		resp.WriteHeaderAndJson(http.StatusBadRequest,
+			result.Set(kerrors.InternalError.Reason, err.Error(), nil), "application/json")
+		return
+	}
+
+	// EventSource reconnect with the last received event id.
+	if in.ResumeToken == "" {
+		in.ResumeToken = req.HeaderParameter("Last-Event-ID")
+	}
+
+	flusher, ok := resp.ResponseWriter.(http.Flusher)
+	if !ok {
+		resp.WriteHeaderAndJson(http.StatusInternalServerError,
+			result.Set(kerrors.InternalError.Reason, "streaming unsupported", nil), "application/json")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(req.Request.Context())
+	defer cancel()
+
+	resp.AddHeader("Content-Type", "text/event-stream")
+	resp.AddHeader("Cache-Control", "no-cache")
+	resp.AddHeader("Connection", "keep-alive")
+	resp.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	stream := &watchEntitiesSSEServer{
+		ctx:     transportHTTP.ContextWithHeader(ctx, req.Request.Header),
+		writer:  resp,
+		flusher: flusher,
+	}
+
+	go stream.keepalive(ctx)
+	if err := h.srv.WatchEntities(&in, stream); err != nil {
+		tErr := kerrors.FromError(err)
+		bytes, _ := protojson.Marshal(&result.Http{Code: tErr.Reason, Msg: tErr.Message})
+		stream.write("event: error\ndata: %s\n\n", bytes)
+	}
+}
+
+// watchEntitiesSSEServer adapt http response to pb.Watch_WatchEntitiesServer.
+type watchEntitiesSSEServer struct {
+	ctx     context.Context
+	lock    sync.Mutex
+	writer  http.ResponseWriter
+	flusher http.Flusher
+}
+
+func (s *watchEntitiesSSEServer) Send(m *pb.WatchEntitiesResponse) error {
+	bytes, err := protojson.MarshalOptions{
+		UseProtoNames:   true,
+		EmitUnpopulated: true,
+	}.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return s.write("id: %s\nevent: change\ndata: %s\n\n", m.ResumeToken, bytes)
+}
+
+func (s *watchEntitiesSSEServer) keepalive(ctx context.Context) {
+	ticker := time.NewTicker(sseKeepaliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.write(": keepalive\n\n"); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (s *watchEntitiesSSEServer) write(format string, args ...interface{}) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, err := fmt.Fprintf(s.writer, format, args...); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+func (s *watchEntitiesSSEServer) Context() context.Context     { return s.ctx }
+func (s *watchEntitiesSSEServer) SetHeader(metadata.MD) error  { return nil }
+func (s *watchEntitiesSSEServer) SendHeader(metadata.MD) error { return nil }
+func (s *watchEntitiesSSEServer) SetTrailer(metadata.MD)       {}
+func (s *watchEntitiesSSEServer) RecvMsg(interface{}) error    { return nil }
+func (s *watchEntitiesSSEServer) SendMsg(m interface{}) error {
+	if out, ok := m.(*pb.WatchEntitiesResponse); ok {
+		return s.Send(out)
+	}
+	return fmt.Errorf("unexpected message type %T", m)
+}
+
+// RegisterWatchHTTPServer register WatchServer to container, changes streamed as Server-Sent Events.
+func RegisterWatchHTTPServer(container *go_restful.Container, srv pb.WatchServer) {
+	var ws *go_restful.WebService
+	for _, v := range container.RegisteredWebServices() {
+		if v.RootPath() == "/v1" {
+			ws = v
+			break
+		}
+	}
+	if ws == nil {
+		ws = new(go_restful.WebService)
+		ws.ApiVersion("/v1")
+		ws.Path("/v1").Produces(go_restful.MIME_JSON)
+		container.Add(ws)
+	}
+
+	handler := &watchHTTPHandler{srv: srv}
+	ws.Route(ws.GET("/entities/watch").
+		Produces("text/event-stream").
+		To(handler.WatchEntities))
+}
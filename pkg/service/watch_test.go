@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	rmock "github.com/tkeel-io/core/pkg/runtime/mock"
+	"google.golang.org/grpc"
+)
+
+// watchStream cancel watching once expected items sent.
+type watchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	expect int
+	items  []*pb.WatchEntitiesResponse
+}
+
+func newWatchStream(ctx context.Context, expect int) *watchStream {
+	ctx, cancel := context.WithCancel(ctx)
+	return &watchStream{ctx: ctx, cancel: cancel, expect: expect}
+}
+
+func (s *watchStream) Context() context.Context { return s.ctx }
+func (s *watchStream) Send(m *pb.WatchEntitiesResponse) error {
+	s.items = append(s.items, m)
+	if len(s.items) >= s.expect {
+		s.cancel()
+	}
+	return nil
+}
+
+func watchHistories() []dao.History {
+	return []dao.History{
+		{EntityID: "device1", EntityType: "DEVICE", EntityOwner: "admin", Version: 2,
+			Patches: []dao.HistoryPatch{{Op: "replace", Path: "properties.temp", Value: []byte("20")}}},
+		{EntityID: "device2", EntityType: "DEVICE", EntityOwner: "admin", Version: 3,
+			Patches: []dao.HistoryPatch{{Op: "replace", Path: "properties.temperature", Value: []byte("21")}}},
+		{EntityID: "device1", EntityType: "DEVICE", EntityOwner: "admin", Version: 4,
+			Patches: []dao.HistoryPatch{
+				{Op: "replace", Path: "properties", Value: []byte(`{"temp":22}`)},
+				{Op: "replace", Path: "properties.metrics.cpu", Value: []byte("0.5")}}},
+		{EntityID: "device3", EntityType: "DEVICE", EntityOwner: "other", Version: 5,
+			Patches: []dao.HistoryPatch{{Op: "replace", Path: "properties.temp", Value: []byte("23")}}},
+	}
+}
+
+func (h *historyHub) watchers() int {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	return len(h.queues)
+}
+
+func Test_WatchEntities(t *testing.T) {
+	ws, err := NewWatchService(context.Background())
+	assert.Nil(t, err)
+	repo := rmock.NewRepo()
+	ws.Init(repo)
+
+	header := http.Header{}
+	header.Set(HeaderOwner, "admin")
+	ctx := context.WithValue(context.Background(), struct{}{}, header)
+
+	t.Run("paths", func(t *testing.T) {
+		stream := newWatchStream(ctx, 2)
+		done := make(chan error, 1)
+		go func() {
+			done <- ws.WatchEntities(&pb.WatchEntitiesRequest{Paths: "properties.temp"}, stream)
+		}()
+
+		// histories put after watching, shared by watchers.
+		assert.Eventually(t, func() bool { return ws.hub.watchers() == 1 }, time.Second, time.Millisecond)
+		for _, h := range watchHistories() {
+			assert.Nil(t, repo.PutHistory(context.Background(), &h))
+		}
+
+		assert.Nil(t, <-done)
+		assert.Len(t, stream.items, 2)
+		assert.Equal(t, "1", stream.items[0].ResumeToken)
+		assert.Equal(t, int64(4), stream.items[1].Version)
+		assert.Len(t, stream.items[1].Changes, 1)
+		assert.Equal(t, "properties", stream.items[1].Changes[0].Path)
+		assert.Equal(t, 0, ws.hub.watchers())
+	})
+
+	t.Run("ids and resume", func(t *testing.T) {
+		stream := newWatchStream(ctx, 1)
+		err = ws.WatchEntities(&pb.WatchEntitiesRequest{Ids: "device1, device3", ResumeToken: "1"}, stream)
+		assert.Nil(t, err)
+		assert.Len(t, stream.items, 1)
+		assert.Equal(t, "3", stream.items[0].ResumeToken)
+		assert.Len(t, stream.items[0].Changes, 2)
+	})
+
+	t.Run("resume before buffered", func(t *testing.T) {
+		// watcher started after histories put, catch up alone.
+		ws2, _ := NewWatchService(context.Background())
+		ws2.Init(repo)
+		stream := newWatchStream(ctx, 1)
+		err = ws2.WatchEntities(&pb.WatchEntitiesRequest{Ids: "device1", ResumeToken: "1"}, stream)
+		assert.Nil(t, err)
+		assert.Len(t, stream.items, 1)
+		assert.Equal(t, "3", stream.items[0].ResumeToken)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		err = ws.WatchEntities(&pb.WatchEntitiesRequest{ResumeToken: "abc"}, newWatchStream(ctx, 0))
+		assert.ErrorIs(t, err, xerrors.ErrInvalidParam)
+		err = ws.WatchEntities(&pb.WatchEntitiesRequest{}, newWatchStream(context.Background(), 0))
+		assert.ErrorIs(t, err, xerrors.ErrInvalidParam)
+	})
+}
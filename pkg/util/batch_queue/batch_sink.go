@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/tkeel-io/kit/log"
@@ -69,6 +70,8 @@ type pendingItem struct {
 	callback   []CallbackFn
 	status     processState
 	err        error
+	// done callbacks triggered.
+	done bool
 }
 
 func (pending *pendingItem) GetSequenceID() uint64 {
@@ -79,6 +82,7 @@ func (pending *pendingItem) Callback() {
 	// lock the pending item
 	pending.Lock()
 	defer pending.Unlock()
+	pending.done = true
 	for _, fn := range pending.callback {
 		fn(pending.sequenceID, pending.err)
 	}
@@ -109,6 +113,8 @@ type batchSink struct {
 	conf *Config
 
 	sendCnt int64
+	// receiptLock serialize receipts of batches processed concurrently.
+	receiptLock sync.Mutex
 
 	ctx context.Context
 }
@@ -125,14 +131,14 @@ type Config struct {
 }
 
 func (c *Config) GetBatchingMaxFlushDelay() time.Duration {
-	if c.BatchingMaxFlushDelay != 0 {
+	if c.BatchingMaxFlushDelay == 0 {
 		c.BatchingMaxFlushDelay = defaultBatchingMaxFlushDelay
 	}
 	return c.BatchingMaxFlushDelay
 }
 
 func (c *Config) GetMaxPendingMessages() int {
-	if c.MaxPendingMessages != 0 {
+	if c.MaxPendingMessages == 0 {
 		c.MaxPendingMessages = defaultMaxPendingMessages
 	}
 	return int(c.MaxPendingMessages)
@@ -199,7 +205,7 @@ func (p *batchSink) internalSend(request *sendRequest) {
 		// The current batch is full then flush it.
 		p.internalFlushCurrentBatch()
 	}
-	p.sendCnt++
+	atomic.AddInt64(&p.sendCnt, 1)
 }
 
 func (p *batchSink) internalFlushCurrentBatch() {
@@ -233,11 +239,17 @@ func (p *batchSink) internalFlush(fr *flushRequest) {
 	// lock the pending request while adding requests
 	// since the ReceivedSendReceipt func iterates over this list
 	pi.Lock()
+	defer pi.Unlock()
+	if pi.done {
+		// processed before callback appended.
+		fr.err = pi.err
+		fr.waitGroup.Done()
+		return
+	}
 	pi.callback = append(pi.callback, func(sequenceID uint64, e error) {
 		fr.err = e
 		fr.waitGroup.Done()
 	})
-	pi.Unlock()
 }
 
 func (p *batchSink) internalClose(req *closeRequest) {
@@ -260,9 +272,11 @@ func (p *batchSink) internalClose(req *closeRequest) {
 
 func (p *batchSink) callbackReceipt(item *pendingItem, err error) {
 	log.L().Debug("Response receipt", zap.Uint64("sequence_id", item.sequenceID))
+	p.receiptLock.Lock()
+	defer p.receiptLock.Unlock()
 	item.status = processIdle
 	item.err = err
-	p.sendCnt -= int64(len(item.batchData))
+	atomic.AddInt64(&p.sendCnt, -int64(len(item.batchData)))
 
 	for {
 		pi, ok := p.pendingQueue.Peek().(*pendingItem)
@@ -287,14 +301,18 @@ func (p *batchSink) callbackReceipt(item *pendingItem, err error) {
 }
 
 func (p *batchSink) Send(ctx context.Context, msg interface{}) error {
-	var err error
 	sr := &sendRequest{
 		ctx: ctx,
 		msg: msg,
 	}
-	p.internalSend(sr)
 
-	return err
+	// batch builder owned by events loop.
+	select {
+	case p.eventsChan <- sr:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 func (p *batchSink) Flush(ctx context.Context) error {
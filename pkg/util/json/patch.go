@@ -23,7 +23,7 @@ import (
 type PatchOp int
 
 // reference: https://datatracker.ietf.org/doc/html/rfc6902 .
-// implement [ add, remove, replace ], reversed [ copy, move, test ].
+// implement [ add, remove, replace, copy, move, test ], extend [ merge ].
 const (
 	OpUndef PatchOp = iota
 	OpAdd
@@ -79,16 +79,56 @@ func (po PatchOp) String() string {
 
 func IsReversedOp(op string) bool {
 	switch op {
-	case "add", "remove", "replace", "copy":
+	case "add", "remove", "replace", "copy", "move", "test":
 		return false
 	default:
 		return true
 	}
 }
 
+// NormalizePath convert json pointer(e.g. /a/b/0) into property path(e.g. a.b[0]).
+func NormalizePath(path string) string {
+	if !strings.HasPrefix(path, "/") {
+		return path
+	}
+
+	var segs []string
+	replacer := strings.NewReplacer("~1", "/", "~0", "~")
+	for _, seg := range strings.Split(path[1:], "/") {
+		seg = replacer.Replace(seg)
+		switch {
+		case seg == "-" && len(segs) > 0:
+			// append to the end of array.
+			continue
+		case isIndex(seg) && len(segs) > 0:
+			segs[len(segs)-1] += "[" + seg + "]"
+		default:
+			segs = append(segs, seg)
+		}
+	}
+	return strings.Join(segs, ".")
+}
+
+func isIndex(seg string) bool {
+	if seg == "" {
+		return false
+	}
+	for _, c := range seg {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
 func IsValidPath(path string) bool {
 	if path == "" || strings.HasPrefix(path, ".") || strings.HasSuffix(path, ".") {
 		return false
 	}
 	return true
 }
+
+// IsChildPath returns true if path is a descendant of parent.
+func IsChildPath(parent, path string) bool {
+	return strings.HasPrefix(path, parent+".") || strings.HasPrefix(path, parent+"[")
+}
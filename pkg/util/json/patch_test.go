@@ -15,3 +15,32 @@ limitations under the License.
 */
 
 package json
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizePath(t *testing.T) {
+	tests := map[string]string{
+		"temp":            "temp",
+		"metrics.cpu":     "metrics.cpu",
+		"/metrics/cpu":    "metrics.cpu",
+		"/interfaces/0":   "interfaces[0]",
+		"/interfaces/-":   "interfaces",
+		"/a~1b/m~0n":      "a/b.m~n",
+		"/metrics/disk/1": "metrics.disk[1]",
+	}
+
+	for path, expect := range tests {
+		assert.Equal(t, expect, NormalizePath(path))
+	}
+}
+
+func TestIsChildPath(t *testing.T) {
+	assert.True(t, IsChildPath("properties.metrics", "properties.metrics.cpu"))
+	assert.True(t, IsChildPath("properties.disks", "properties.disks[0]"))
+	assert.False(t, IsChildPath("properties.metrics", "properties.metrics"))
+	assert.False(t, IsChildPath("properties.metrics", "properties.metrics2"))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -11,16 +12,26 @@ import (
 	"github.com/dapr/kit/retry"
 	"github.com/pkg/errors"
 	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/deadletter"
 	zfield "github.com/tkeel-io/core/pkg/logger"
 	"github.com/tkeel-io/kit/log"
 	"go.uber.org/zap"
 )
 
+const (
+	retryInterval       = time.Second
+	maxDeliveryAttempts = 3
+	// flushInterval interval of flushing handled messages, aligned with offsets commit interval.
+	flushInterval = time.Second
+)
+
 type kafkaMetadata struct {
 	Topic   string   `json:"topic" mapstructure:"topic"`
 	Group   string   `json:"group" mapstructure:"group"`
 	Brokers []string `json:"brokers" mapstructure:"brokers"`
 	Timeout int64    `json:"timeout" mapstructure:"timeout"`
+	// weight of queue placement, kafka://host:port/topic/group?weight=2 .
+	Weight int `json:"weight" mapstructure:"weight"`
 }
 
 func parseURL(sink string) (*kafkaMetadata, error) {
@@ -34,13 +45,30 @@ func parseURL(sink string) (*kafkaMetadata, error) {
 		return nil, errors.New("invalid sink")
 	}
 
+	weight := 0
+	if val := urlIns.Query().Get("weight"); val != "" {
+		if weight, err = strconv.Atoi(val); nil != err || weight <= 0 {
+			return nil, errors.Errorf("invalid weight %s", val)
+		}
+	}
+
 	return &kafkaMetadata{
 		Topic:   segs[1],
 		Group:   segs[2],
 		Brokers: strings.Split(urlIns.Host, ","),
+		Weight:  weight,
 	}, nil
 }
 
+// ParseID returns id of pubsub url, without connecting brokers.
+func ParseID(urlText string) (string, error) {
+	kafkaMeta, err := parseURL(urlText)
+	if nil != err {
+		return "", errors.Wrap(err, "decode pubsub.kafka configuration")
+	}
+	return kafkaMeta.Topic, nil
+}
+
 func NewKafkaPubsub(urlText string) (*Pubsub, error) {
 	var (
 		err       error
@@ -78,12 +106,19 @@ type Pubsub struct {
 	kafkaConsumer sarama.ConsumerGroup
 	kafkaProducer sarama.SyncProducer
 	kafkaMetadata *kafkaMetadata
+	// closed when consumer stopped.
+	consumeDone chan struct{}
 }
 
 func (k *Pubsub) ID() string {
 	return k.id
 }
 
+// Weight returns placement weight of queue.
+func (k *Pubsub) Weight() int {
+	return k.kafkaMetadata.Weight
+}
+
 func (k *Pubsub) Send(ctx context.Context, event v1.Event) error {
 	var (
 		err      error
@@ -113,6 +148,11 @@ type KafkaReceiver interface { //nolint
 	HandleMessage(context.Context, *sarama.ConsumerMessage) error
 }
 
+// KafkaFlusher receiver flush state of handled messages, offsets marked once flushed, optional.
+type KafkaFlusher interface { //nolint
+	FlushMessages(ctx context.Context, topic string) error
+}
+
 func (k *Pubsub) Received(ctx context.Context, receiver KafkaReceiver) error {
 	c, err := sarama.NewConsumerGroupFromClient(k.kafkaMetadata.Group, k.kafkaClient)
 	if nil != err {
@@ -122,10 +162,12 @@ func (k *Pubsub) Received(ctx context.Context, receiver KafkaReceiver) error {
 	}
 
 	k.kafkaConsumer = c
+	k.consumeDone = make(chan struct{})
 	log.L().Debug("start receive", zfield.ID(k.id), zfield.Topic(k.kafkaMetadata.Topic),
 		zfield.Endpoints(k.kafkaMetadata.Brokers), zfield.Group(k.kafkaMetadata.Group))
 
 	go func() {
+		defer close(k.consumeDone)
 		defer func() {
 			log.L().Debug("Closing ConsumerGroup for topics", zfield.Topic(k.kafkaMetadata.Topic),
 				zfield.ID(k.id), zfield.Endpoints(k.kafkaMetadata.Brokers), zfield.Group(k.kafkaMetadata.Group))
@@ -138,9 +180,13 @@ func (k *Pubsub) Received(ctx context.Context, receiver KafkaReceiver) error {
 		log.L().Debug("Subscribed and listening to topics", zfield.Topic(k.kafkaMetadata.Topic),
 			zfield.ID(k.id), zfield.Endpoints(k.kafkaMetadata.Brokers), zfield.Group(k.kafkaMetadata.Group))
 
+		// flush on claim closed within half of rebalance timeout, leaving the rest to commit offsets.
+		consumer := &kafkaConsumer{receiver: receiver,
+			closeTimeout: k.kafkaClient.Config().Consumer.Group.Rebalance.Timeout / 2}
+
 		for {
 			// Consume the requested topic.
-			if innerError := k.kafkaConsumer.Consume(ctx, []string{k.kafkaMetadata.Topic}, &kafkaConsumer{receiver: receiver}); innerError != nil {
+			if innerError := k.kafkaConsumer.Consume(ctx, []string{k.kafkaMetadata.Topic}, consumer); innerError != nil {
 				log.L().Error("Error closing consumer group", zap.Error(innerError), zfield.Topic(k.kafkaMetadata.Topic),
 					zfield.ID(k.id), zfield.Endpoints(k.kafkaMetadata.Brokers), zfield.Group(k.kafkaMetadata.Group))
 			}
@@ -155,17 +201,37 @@ func (k *Pubsub) Received(ctx context.Context, receiver KafkaReceiver) error {
 	return nil
 }
 
+// Done returns channel closed once consumer stopped, offsets of handled messages committed.
+func (k *Pubsub) Done() <-chan struct{} {
+	if nil == k.consumeDone {
+		done := make(chan struct{})
+		close(done)
+		return done
+	}
+	return k.consumeDone
+}
+
 func (k *Pubsub) Commit(v interface{}) error {
 	return nil
 }
 
+// Close release producer and client, the context of Received must be canceled before.
 func (k *Pubsub) Close() error {
-	log.L().Info("pubsub.noop close", zfield.ID(k.id))
-	return nil
+	log.L().Info("pubsub.kafka close", zfield.ID(k.id))
+	if nil != k.consumeDone {
+		<-k.consumeDone
+	}
+
+	if err := k.kafkaProducer.Close(); nil != err {
+		log.L().Warn("close kafka producer", zap.Error(err), zfield.ID(k.id))
+	}
+	return errors.Wrap(k.kafkaClient.Close(), "close kafka client")
 }
 
 type kafkaConsumer struct {
 	receiver KafkaReceiver
+	// timeout of flushing handled messages once claim closed.
+	closeTimeout time.Duration
 }
 
 func (consumer *kafkaConsumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
@@ -173,32 +239,103 @@ func (consumer *kafkaConsumer) ConsumeClaim(session sarama.ConsumerGroupSession,
 		return fmt.Errorf("nil consumer callback")
 	}
 
-	backOffConfig := retry.Config{}
-	b := backOffConfig.NewBackOffWithContext(session.Context())
-	for msg := range claim.Messages() {
-		if err := retry.NotifyRecover(func() error {
-			var innerErr error
-			log.L().Debug("processing kafka message", zfield.Topic(msg.Topic),
-				zfield.Partition(msg.Partition), zfield.Offset(msg.Offset), zfield.Key(string(msg.Key)))
-			if innerErr = consumer.receiver.HandleMessage(session.Context(), msg); innerErr == nil {
+	// offsets marked once state of handled messages flushed.
+	flusher, deferred := consumer.receiver.(KafkaFlusher)
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	var handled *sarama.ConsumerMessage
+	for {
+		select {
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				if deferred {
+					consumer.flushClosed(session, flusher, handled)
+				}
+				return nil
+			}
+
+			if err := consumer.handleMessage(session, msg); nil != err {
+				if deferred {
+					consumer.flushClosed(session, flusher, handled)
+				}
+				return err
+			}
+
+			if !deferred {
 				session.MarkMessage(msg, "")
+				continue
+			}
+			handled = msg
+		case <-ticker.C:
+			if deferred {
+				handled = consumer.markFlushed(session.Context(), session, flusher, handled)
 			}
-			log.L().Debug("processing kafka message", zfield.Topic(msg.Topic),
-				zfield.Partition(msg.Partition), zfield.Offset(msg.Offset), zfield.Key(string(msg.Key)))
-			return errors.Wrap(innerErr, "handle message")
-		}, b, func(err error, d time.Duration) {
-			log.L().Debug("processing kafka message", zfield.Topic(msg.Topic),
-				zfield.Partition(msg.Partition), zfield.Offset(msg.Offset), zfield.Key(string(msg.Key)))
-		}, func() {
-			log.L().Debug("processing kafka message", zfield.Topic(msg.Topic),
-				zfield.Partition(msg.Partition), zfield.Offset(msg.Offset), zfield.Key(string(msg.Key)))
-		}); err != nil {
-			log.L().Error("processing kafka message", zfield.Topic(msg.Topic),
-				zfield.Partition(msg.Partition), zfield.Offset(msg.Offset), zfield.Key(string(msg.Key)))
-			return errors.Wrap(err, "handle message")
 		}
 	}
+}
+
+// flushClosed mark handled message once flushed within close timeout, context of session canceled already,
+// the partition revoked if not returned before rebalance timeout.
+func (consumer *kafkaConsumer) flushClosed(session sarama.ConsumerGroupSession, flusher KafkaFlusher, msg *sarama.ConsumerMessage) {
+	ctx, cancel := context.WithTimeout(context.Background(), consumer.closeTimeout)
+	defer cancel()
+	consumer.markFlushed(ctx, session, flusher, msg)
+}
+
+// markFlushed mark handled message once flushed, returns the message if not marked.
+func (consumer *kafkaConsumer) markFlushed(ctx context.Context, session sarama.ConsumerGroupSession, flusher KafkaFlusher, msg *sarama.ConsumerMessage) *sarama.ConsumerMessage {
+	if nil == msg {
+		return nil
+	}
+
+	if err := flusher.FlushMessages(ctx, msg.Topic); nil != err {
+		log.L().Error("flush handled messages", zap.Error(err), zfield.Topic(msg.Topic),
+			zfield.Partition(msg.Partition), zfield.Offset(msg.Offset))
+		return msg
+	}
 
+	session.MarkMessage(msg, "")
+	return nil
+}
+
+func (consumer *kafkaConsumer) handleMessage(session sarama.ConsumerGroupSession, msg *sarama.ConsumerMessage) error {
+	backOffConfig := retry.Config{
+		Policy:     retry.PolicyConstant,
+		Duration:   retryInterval,
+		MaxRetries: maxDeliveryAttempts - 1,
+	}
+
+	var attempts int64
+	b := backOffConfig.NewBackOffWithContext(session.Context())
+	if err := retry.NotifyRecover(func() error {
+		attempts++
+		log.L().Debug("processing kafka message", zfield.Topic(msg.Topic),
+			zfield.Partition(msg.Partition), zfield.Offset(msg.Offset), zfield.Key(string(msg.Key)))
+		return errors.Wrap(consumer.receiver.HandleMessage(session.Context(), msg), "handle message")
+	}, b, func(err error, d time.Duration) {
+		log.L().Debug("processing kafka message", zap.Error(err), zfield.Topic(msg.Topic),
+			zfield.Partition(msg.Partition), zfield.Offset(msg.Offset), zfield.Key(string(msg.Key)))
+	}, func() {
+		log.L().Debug("processing kafka message", zfield.Topic(msg.Topic),
+			zfield.Partition(msg.Partition), zfield.Offset(msg.Offset), zfield.Key(string(msg.Key)))
+	}); err != nil {
+		log.L().Error("processing kafka message", zap.Error(err), zfield.Topic(msg.Topic),
+			zfield.Partition(msg.Partition), zfield.Offset(msg.Offset), zfield.Key(string(msg.Key)))
+		if session.Context().Err() != nil {
+			return errors.Wrap(err, "handle message")
+		}
+
+		// keep message uncommitted if dead letter lost.
+		if err = deadletter.Global().Push(session.Context(), &deadletter.Letter{
+			RuntimeID: msg.Topic,
+			Raw:       msg.Value,
+			Err:       err,
+			Attempts:  attempts,
+		}); nil != err {
+			return errors.Wrap(err, "push dead letter")
+		}
+	}
 	return nil
 }
 